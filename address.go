@@ -0,0 +1,462 @@
+package chinaid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Address holds the components of a Chinese postal address. Numbers are kept
+// without their suffixes, e.g. HouseNumber "100" for "100号".
+type Address struct {
+	Province    string // province short name, e.g. "浙江"
+	City        string // prefecture-level city name, e.g. "杭州市"
+	District    string // county-level district name, e.g. "西湖区"
+	AreaCode    string // code of the most specific region found, e.g. "330106"
+	Street      string // street or road, e.g. "文三路"
+	HouseNumber string // house number on the street, e.g. "100"
+	Community   string // residential community, e.g. "阳光花园"
+	Building    string // building number, e.g. "3" for "3栋" or "3号楼"
+	Unit        string // unit number, e.g. "3" for "3单元"
+	Room        string // room number, e.g. "502" for "502室"
+}
+
+// String formats the address the way generated addresses are written, e.g.
+// "浙江杭州市西湖区文三路100号阳光花园3单元502室". Empty components are left out.
+func (a Address) String() string {
+	return regionName(a.Province, a.City, a.District) + a.detail()
+}
+
+// Full formats the address with the full province name, e.g.
+// "浙江省杭州市西湖区文三路100号阳光花园3单元502室" or "北京市朝阳区建国路88号".
+func (a Address) Full() string {
+	return strings.Join(a.regionNames(), "") + a.detail()
+}
+
+// Short formats the address without the province, e.g.
+// "杭州市西湖区文三路100号阳光花园3单元502室". A municipality is kept as the
+// city, e.g. "北京市朝阳区建国路88号".
+func (a Address) Short() string {
+	region := a.City
+	if a.District != a.City {
+		region += a.District
+	}
+	return region + a.detail()
+}
+
+// Label formats the address on one line for a courier label, with the
+// province, city, district and the rest separated by spaces, e.g.
+// "浙江省 杭州市 西湖区 文三路100号阳光花园3单元502室".
+func (a Address) Label() string {
+	parts := a.regionNames()
+	if detail := a.detail(); detail != "" {
+		parts = append(parts, detail)
+	}
+	return strings.Join(parts, " ")
+}
+
+// English formats the address in English order with names in pinyin, e.g.
+// "Room 502, Unit 3, Yangguanghuayuan, 100 Wensan Road, Xihu District,
+// Hangzhou, Zhejiang, China".
+func (a Address) English() string {
+	var parts []string
+	if a.Room != "" {
+		parts = append(parts, "Room "+a.Room)
+	}
+	if a.Unit != "" {
+		parts = append(parts, "Unit "+a.Unit)
+	}
+	if a.Building != "" {
+		parts = append(parts, "Building "+a.Building)
+	}
+	if a.Community != "" {
+		parts = append(parts, englishName(a.Community, nil))
+	}
+	switch {
+	case a.Street != "" && a.HouseNumber != "":
+		parts = append(parts, a.HouseNumber+" "+englishName(a.Street, streetEnglishSuffixes))
+	case a.Street != "":
+		parts = append(parts, englishName(a.Street, streetEnglishSuffixes))
+	case a.HouseNumber != "":
+		parts = append(parts, "No. "+a.HouseNumber)
+	}
+	if a.District != "" && a.District != a.City {
+		parts = append(parts, englishName(a.District, regionEnglishSuffixes))
+	}
+	if a.City != "" && !a.isMunicipality() {
+		parts = append(parts, englishName(a.City, regionEnglishSuffixes))
+	}
+	if a.Province != "" {
+		parts = append(parts, englishName(a.Province, nil))
+	}
+	return strings.Join(append(parts, "China"), ", ")
+}
+
+// detail formats the components after the district.
+func (a Address) detail() string {
+	var sb strings.Builder
+	sb.WriteString(a.Street)
+	writeNumber(&sb, a.HouseNumber, "号")
+	sb.WriteString(a.Community)
+	writeNumber(&sb, a.Building, "栋")
+	writeNumber(&sb, a.Unit, "单元")
+	writeNumber(&sb, a.Room, "室")
+	return sb.String()
+}
+
+func writeNumber(sb *strings.Builder, number, suffix string) {
+	if number != "" {
+		sb.WriteString(number)
+		sb.WriteString(suffix)
+	}
+}
+
+// regionNames returns the full province name, the city unless it is a
+// municipality, and the district unless it is named like its city.
+func (a Address) regionNames() []string {
+	var names []string
+	if r, err := LookupRegion(provinceCode(a.Province)); err == nil {
+		names = append(names, r.Name())
+	} else if a.Province != "" {
+		names = append(names, a.Province)
+	}
+	if a.City != "" && !a.isMunicipality() {
+		names = append(names, a.City)
+	}
+	if a.District != "" && a.District != a.City {
+		names = append(names, a.District)
+	}
+	return names
+}
+
+// isMunicipality reports whether the city is the municipality of the province.
+func (a Address) isMunicipality() bool {
+	r, err := LookupRegion(provinceCode(a.Province))
+	return err == nil && r.Name() == a.City
+}
+
+// englishSuffix maps an administrative or street suffix to English.
+type englishSuffix struct {
+	suffix  string
+	english string
+}
+
+// Region suffixes translated by English, longest first. Cities drop "市".
+var regionEnglishSuffixes = []englishSuffix{
+	{"自治州", "Autonomous Prefecture"}, {"自治县", "Autonomous County"}, {"自治旗", "Autonomous Banner"},
+	{"地区", "Prefecture"}, {"新区", "New Area"}, {"矿区", "Mining District"},
+	{"市", ""}, {"区", "District"}, {"县", "County"}, {"旗", "Banner"}, {"盟", "League"},
+}
+
+// Street suffixes translated by English, longest first.
+var streetEnglishSuffixes = []englishSuffix{
+	{"大街", "Street"}, {"大道", "Avenue"}, {"胡同", "Hutong"},
+	{"路", "Road"}, {"街", "Street"}, {"道", "Road"}, {"巷", "Lane"}, {"弄", "Lane"},
+}
+
+// Official English names that differ from the plain pinyin. 陕西 is spelled
+// "Shaanxi" to tell it apart from 山西 ("Shanxi").
+var englishOverrides = map[string]string{
+	"陕西": "Shaanxi",
+}
+
+// englishName writes name in capitalized pinyin, translating the first of
+// suffixes it ends with, e.g. "Xihu District" for "西湖区". The whole name is
+// converted first so that readings such as "shanxian" for "单县" are kept.
+func englishName(name string, suffixes []englishSuffix) string {
+	if english, ok := englishOverrides[name]; ok {
+		return english
+	}
+	py := convertPinyin(name, true)
+	english := ""
+	for _, s := range suffixes {
+		if !strings.HasSuffix(name, s.suffix) || name == s.suffix {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(py, convertPinyin(s.suffix, false)); ok && prefix != "" {
+			py, english = prefix, s.english
+			break
+		}
+	}
+	if py != "" {
+		py = strings.ToUpper(py[:1]) + py[1:]
+	}
+	if english == "" {
+		return py
+	}
+	return py + " " + english
+}
+
+// regionName joins the province, city and district for an address, leaving
+// out the city of a municipality ("北京朝阳区") and a district named after its
+// city ("广东东莞市").
+func regionName(province, city, district string) string {
+	region := province
+	if r, err := LookupRegion(provinceCode(province)); err != nil || r.Name() != city {
+		region += city
+	}
+	if district != city {
+		region += district
+	}
+	return region
+}
+
+// provinceCode returns the 2-digit code of the province with the given short
+// name, or "" if there is none.
+func provinceCode(short string) string {
+	for _, p := range regionProvinces {
+		if p.shortName == short {
+			return p.code[:2]
+		}
+	}
+	return ""
+}
+
+// Building suffixes recognized by ParseAddress, longest first.
+var buildingSuffixes = []string{"号楼", "栋", "幢", "座", "楼"}
+
+// Street suffixes recognized by ParseAddress when there is no house number.
+var streetSuffixes = []string{"大街", "大道", "胡同", "路", "街", "道", "巷", "弄"}
+
+// ParseAddress splits a free-form address such as
+// "浙江省杭州市西湖区文三路100号阳光花园3单元502室" into its components.
+//
+// The province, city and district are matched against the region data; the
+// province and city may be written with or without their suffixes and may be
+// left out if the rest identifies them. The street ends at the house number
+// ("号"), and the building ("栋", "幢", "座", "号楼", "楼"), unit ("单元") and
+// room ("室", or digits after a unit or building) are read from the end.
+//
+// It returns an error wrapping ErrRegionNotFound if no province, city or
+// district is recognized.
+func ParseAddress(address string) (*Address, error) {
+	s := strings.Join(strings.Fields(address), "")
+	a := &Address{}
+	rest, ok := parseAddressRegion(s, a)
+	if !ok {
+		return nil, fmt.Errorf("%w: no region in address %q", ErrRegionNotFound, address)
+	}
+
+	rest, a.Room = cutNumberSuffix(rest, []string{"室"})
+	if a.Room == "" {
+		// Bare trailing digits after a unit or building are the room.
+		if r, room := cutNumberSuffix(rest, []string{""}); room != "" && (strings.HasSuffix(r, "单元") || hasAnySuffix(r, buildingSuffixes)) {
+			rest, a.Room = r, room
+		}
+	}
+	rest, a.Unit = cutNumberSuffix(rest, []string{"单元"})
+	rest, a.Building = cutNumberSuffix(rest, buildingSuffixes)
+
+	if i, j := houseNumberIndex(rest); i >= 0 {
+		a.Street = rest[:i]
+		a.HouseNumber = rest[i:j]
+		a.Community = rest[j+len("号"):]
+		return a, nil
+	}
+	end := -1
+	for _, suffix := range streetSuffixes {
+		if i := strings.Index(rest, suffix); i >= 0 && (end < 0 || i+len(suffix) < end) {
+			end = i + len(suffix)
+		}
+	}
+	if end < 0 {
+		a.Community = rest
+	} else {
+		a.Street, a.Community = rest[:end], rest[end:]
+	}
+	return a, nil
+}
+
+// cutNumberSuffix removes a trailing number followed by one of suffixes from
+// s, returning the rest and the number, or s and "" if there is none.
+func cutNumberSuffix(s string, suffixes []string) (string, string) {
+	for _, suffix := range suffixes {
+		if !strings.HasSuffix(s, suffix) {
+			continue
+		}
+		end := len(s) - len(suffix)
+		start := end
+		for start > 0 && (isDigit(s[start-1]) || s[start-1] == '-') {
+			start--
+		}
+		if start < end && isDigit(s[start]) {
+			return s[:start], s[start:end]
+		}
+	}
+	return s, ""
+}
+
+// hasAnySuffix reports whether s ends with one of suffixes.
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// houseNumberIndex returns the start and end of the first number followed by
+// "号" but not "号楼", or -1, -1 if there is none.
+func houseNumberIndex(s string) (int, int) {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			continue
+		}
+		j := i
+		for j < len(s) && (isDigit(s[j]) || s[j] == '-') {
+			j++
+		}
+		if strings.HasPrefix(s[j:], "号") && !strings.HasPrefix(s[j:], "号楼") {
+			return i, j
+		}
+		i = j
+	}
+	return -1, -1
+}
+
+// parseAddressRegion reads the province, city and district at the start of s
+// into a, returning the rest of s and whether any region was found. When the
+// province can be read either way, as in "吉林市昌邑区", the reading that
+// consumes more of s wins.
+func parseAddressRegion(s string, a *Address) (string, bool) {
+	var best []*Region
+	bestLen := 0
+	for _, withProvince := range []bool{true, false} {
+		regions, n := matchAddressRegion(s, withProvince)
+		if n > bestLen {
+			best, bestLen = regions, n
+		}
+	}
+	if best == nil {
+		return s, false
+	}
+
+	var r *Region
+	for _, r = range best {
+		switch r.level {
+		case RegionProvince:
+			a.Province = r.shortName
+		case RegionCity:
+			a.City = r.name
+		case RegionDistrict:
+			a.District = r.name
+		}
+	}
+	a.AreaCode = r.code
+	return s[bestLen:], true
+}
+
+// matchAddressRegion matches the province (if withProvince), city and
+// district at the start of s, inferring the levels above the most specific
+// one matched. It returns them from the province down and the length matched.
+func matchAddressRegion(s string, withProvince bool) ([]*Region, int) {
+	n := 0
+	var prov, city, district *Region
+	if withProvince {
+		var m int
+		if prov, m = matchRegionPrefix(s, regionProvinces); prov == nil {
+			return nil, 0
+		}
+		n += m
+	}
+
+	var cities []*Region
+	if prov != nil {
+		cities = prov.children
+	} else {
+		for _, p := range regionProvinces {
+			cities = append(cities, p.children...)
+		}
+	}
+	if c, m := matchRegionPrefix(s[n:], cities); c != nil {
+		city = c
+		n += m
+	} else if prov != nil && len(prov.children) == 1 {
+		city = prov.children[0]
+	}
+
+	if city != nil {
+		if len(city.children) == 1 && city.children[0].name == city.name {
+			district = city.children[0]
+		} else if d, m := matchRegionName(s[n:], city.children); d != nil {
+			district = d
+			n += m
+		}
+	} else {
+		// Without a city, the district must be unique within the province or
+		// the whole country.
+		var matched []*Region
+		m := 0
+		for _, c := range cities {
+			for _, d := range c.children {
+				switch {
+				case len(d.name) < m || !strings.HasPrefix(s[n:], d.name):
+				case len(d.name) > m:
+					matched, m = []*Region{d}, len(d.name)
+				default:
+					matched = append(matched, d)
+				}
+			}
+		}
+		if len(matched) == 1 {
+			district, city = matched[0], matched[0].parent
+			n += m
+		}
+	}
+
+	r := district
+	if r == nil {
+		r = city
+	}
+	if r == nil {
+		r = prov
+	}
+	if r == nil {
+		return nil, 0
+	}
+	var result []*Region
+	for ; r != nil; r = r.parent {
+		result = append([]*Region{r}, result...)
+	}
+	return result, n
+}
+
+// matchRegionPrefix returns the region among regions whose name, short name
+// or name without its administrative suffix starts s, preferring the longest
+// match, and the length matched.
+func matchRegionPrefix(s string, regions []*Region) (*Region, int) {
+	var best *Region
+	bestLen := 0
+	for _, r := range regions {
+		for _, name := range []string{r.name, r.shortName, trimRegionSuffix(r.name)} {
+			if len(name) > bestLen && strings.HasPrefix(s, name) {
+				best, bestLen = r, len(name)
+			}
+		}
+	}
+	return best, bestLen
+}
+
+// matchRegionName returns the region among regions whose full name starts s,
+// preferring the longest match, and the length matched.
+func matchRegionName(s string, regions []*Region) (*Region, int) {
+	var best *Region
+	bestLen := 0
+	for _, r := range regions {
+		if len(r.name) > bestLen && strings.HasPrefix(s, r.name) {
+			best, bestLen = r, len(r.name)
+		}
+	}
+	return best, bestLen
+}
+
+// trimRegionSuffix removes the administrative suffix from name, keeping at
+// least two characters, e.g. "杭州市" becomes "杭州" but "沙县" is kept.
+func trimRegionSuffix(name string) string {
+	for _, suffix := range citySuffixes {
+		if trimmed, ok := strings.CutSuffix(name, suffix); ok && len([]rune(trimmed)) >= 2 {
+			return trimmed
+		}
+	}
+	return name
+}
@@ -0,0 +1,184 @@
+package chinaid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    Address
+	}{
+		{
+			"浙江省杭州市西湖区文三路100号阳光花园3单元502室",
+			Address{Province: "浙江", City: "杭州市", District: "西湖区", AreaCode: "330106",
+				Street: "文三路", HouseNumber: "100", Community: "阳光花园", Unit: "3", Room: "502"},
+		},
+		{
+			"浙江杭州西湖区文三路100号",
+			Address{Province: "浙江", City: "杭州市", District: "西湖区", AreaCode: "330106",
+				Street: "文三路", HouseNumber: "100"},
+		},
+		{
+			"北京市朝阳区建国路88号华贸中心2号楼1单元1801室",
+			Address{Province: "北京", City: "北京市", District: "朝阳区", AreaCode: "110105",
+				Street: "建国路", HouseNumber: "88", Community: "华贸中心", Building: "2", Unit: "1", Room: "1801"},
+		},
+		{
+			"北京朝阳区",
+			Address{Province: "北京", City: "北京市", District: "朝阳区", AreaCode: "110105"},
+		},
+		{
+			"吉林市昌邑区解放路12-3号翠苑花园5栋201",
+			Address{Province: "吉林", City: "吉林市", District: "昌邑区", AreaCode: "220202",
+				Street: "解放路", HouseNumber: "12-3", Community: "翠苑花园", Building: "5", Room: "201"},
+		},
+		{
+			"广东东莞市南城街道鸿福路1号",
+			Address{Province: "广东", City: "东莞市", District: "东莞市", AreaCode: "441900",
+				Street: "南城街道鸿福路", HouseNumber: "1"},
+		},
+		{
+			"杭州市滨江区江南大道阳光花园 3幢 2单元 301室",
+			Address{Province: "浙江", City: "杭州市", District: "滨江区", AreaCode: "330108",
+				Street: "江南大道", Community: "阳光花园", Building: "3", Unit: "2", Room: "301"},
+		},
+		{
+			"江苏省",
+			Address{Province: "江苏", AreaCode: "320000"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := ParseAddress(tt.address)
+		if err != nil {
+			t.Errorf("ParseAddress(%s) returned error: %v", tt.address, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("ParseAddress(%s) =\n%+v, want\n%+v", tt.address, *got, tt.want)
+		}
+	}
+}
+
+func TestParseAddressErrors(t *testing.T) {
+	for _, address := range []string{"", "文三路100号", "哥谭市犯罪巷1号"} {
+		if _, err := ParseAddress(address); !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("ParseAddress(%q) error = %v, want ErrRegionNotFound", address, err)
+		}
+	}
+}
+
+func TestParseAddressGenerated(t *testing.T) {
+	for _, p := range NewPerson().Seed(1).BuildN(5000) {
+		a, err := ParseAddress(p.Address())
+		if err != nil {
+			t.Fatalf("ParseAddress(%s) returned error: %v", p.Address(), err)
+		}
+		if a.Province != p.Province() || a.City != p.City() || a.District != p.District() {
+			t.Fatalf("ParseAddress(%s): region = %s/%s/%s, want %s/%s/%s",
+				p.Address(), a.Province, a.City, a.District, p.Province(), p.City(), p.District())
+		}
+		if a.Street == "" || a.HouseNumber == "" || a.Community == "" || a.Unit == "" || a.Room == "" {
+			t.Fatalf("ParseAddress(%s) = %+v, want all components", p.Address(), *a)
+		}
+		if a.String() != p.Address() {
+			t.Fatalf("ParseAddress(%s).String() = %s", p.Address(), a.String())
+		}
+	}
+}
+
+func TestAddressFormats(t *testing.T) {
+	tests := []struct {
+		addr                             Address
+		str, full, short, label, english string
+	}{
+		{
+			Address{Province: "浙江", City: "杭州市", District: "西湖区", AreaCode: "330106",
+				Street: "文三路", HouseNumber: "100", Community: "阳光花园", Unit: "3", Room: "502"},
+			"浙江杭州市西湖区文三路100号阳光花园3单元502室",
+			"浙江省杭州市西湖区文三路100号阳光花园3单元502室",
+			"杭州市西湖区文三路100号阳光花园3单元502室",
+			"浙江省 杭州市 西湖区 文三路100号阳光花园3单元502室",
+			"Room 502, Unit 3, Yangguanghuayuan, 100 Wensan Road, Xihu District, Hangzhou, Zhejiang, China",
+		},
+		{
+			Address{Province: "北京", City: "北京市", District: "朝阳区", AreaCode: "110105",
+				Street: "建国路", HouseNumber: "88", Community: "华贸中心", Building: "2", Unit: "1", Room: "1801"},
+			"北京朝阳区建国路88号华贸中心2栋1单元1801室",
+			"北京市朝阳区建国路88号华贸中心2栋1单元1801室",
+			"北京市朝阳区建国路88号华贸中心2栋1单元1801室",
+			"北京市 朝阳区 建国路88号华贸中心2栋1单元1801室",
+			"Room 1801, Unit 1, Building 2, Huamaozhongxin, 88 Jianguo Road, Chaoyang District, Beijing, China",
+		},
+		{
+			Address{Province: "广东", City: "东莞市", District: "东莞市", AreaCode: "441900",
+				Street: "鸿福路", HouseNumber: "1"},
+			"广东东莞市鸿福路1号",
+			"广东省东莞市鸿福路1号",
+			"东莞市鸿福路1号",
+			"广东省 东莞市 鸿福路1号",
+			"1 Hongfu Road, Dongguan, Guangdong, China",
+		},
+		{
+			Address{Province: "福建", City: "厦门市", District: "思明区", AreaCode: "350203",
+				Street: "长江大道", Community: "CBD国际"},
+			"福建厦门市思明区长江大道CBD国际",
+			"福建省厦门市思明区长江大道CBD国际",
+			"厦门市思明区长江大道CBD国际",
+			"福建省 厦门市 思明区 长江大道CBD国际",
+			"CBDguoji, Changjiang Avenue, Siming District, Xiamen, Fujian, China",
+		},
+		{
+			Address{Province: "江苏", AreaCode: "320000"},
+			"江苏", "江苏省", "", "江苏省", "Jiangsu, China",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.addr.String(); got != tt.str {
+			t.Errorf("String() = %q, want %q", got, tt.str)
+		}
+		if got := tt.addr.Full(); got != tt.full {
+			t.Errorf("Full() = %q, want %q", got, tt.full)
+		}
+		if got := tt.addr.Short(); got != tt.short {
+			t.Errorf("Short() = %q, want %q", got, tt.short)
+		}
+		if got := tt.addr.Label(); got != tt.label {
+			t.Errorf("Label() = %q, want %q", got, tt.label)
+		}
+		if got := tt.addr.English(); got != tt.english {
+			t.Errorf("English() = %q, want %q", got, tt.english)
+		}
+	}
+}
+
+func TestAddressEnglishProvinces(t *testing.T) {
+	shaanxi := Address{Province: "陕西", City: "西安市", District: "雁塔区", AreaCode: "610113"}.English()
+	shanxi := Address{Province: "山西", City: "太原市", District: "小店区", AreaCode: "140105"}.English()
+	if !strings.HasSuffix(shaanxi, ", Shaanxi, China") {
+		t.Errorf("陕西 English() = %q, want Shaanxi", shaanxi)
+	}
+	if !strings.HasSuffix(shanxi, ", Shanxi, China") {
+		t.Errorf("山西 English() = %q, want Shanxi", shanxi)
+	}
+}
+
+func TestAddressFormatsGenerated(t *testing.T) {
+	for _, p := range NewPerson().Seed(1).BuildN(5000) {
+		a := p.AddressDetail()
+		for _, s := range []string{a.Full(), a.Short(), a.Label()} {
+			parsed, err := ParseAddress(s)
+			if err != nil {
+				t.Fatalf("ParseAddress(%s) returned error: %v", s, err)
+			}
+			if *parsed != a {
+				t.Fatalf("ParseAddress(%s) =\n%+v, want\n%+v", s, *parsed, a)
+			}
+		}
+		if english := a.English(); !strings.HasSuffix(english, ", China") || strings.ContainsFunc(english, func(r rune) bool { return r >= 0x80 }) {
+			t.Fatalf("English() = %q for %s, want ASCII ending in China", english, a.String())
+		}
+	}
+}
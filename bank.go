@@ -1,5 +1,11 @@
 package chinaid
 
+import (
+	"strconv"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
 func calculateLUHNCheckDigit(cardNo string) int {
 	sum := 0
 	for i := len(cardNo) - 1; i >= 0; i-- {
@@ -15,25 +21,134 @@ func calculateLUHNCheckDigit(cardNo string) int {
 	return (10 - sum%10) % 10
 }
 
-// ValidateLUHN validates a bank card number using the LUHN algorithm.
-func ValidateLUHN(cardNo string) bool {
-	if len(cardNo) < 13 || len(cardNo) > 19 {
-		return false
+// bankCardNo fills prefix with random digits up to length and appends the LUHN check digit.
+func bankCardNo(rng *Rng, prefix string, length int) string {
+	cardNo := []byte(prefix)
+	for len(cardNo) < length-1 {
+		cardNo = append(cardNo, byte('0'+rng.Intn(10)))
 	}
+	return string(cardNo) + strconv.Itoa(calculateLUHNCheckDigit(string(cardNo)))
+}
 
-	sum := 0
-	for i := len(cardNo) - 1; i >= 0; i-- {
-		digit := int(cardNo[i] - '0')
-		if digit < 0 || digit > 9 {
-			return false
-		}
-		if (len(cardNo)-i)%2 == 0 {
-			digit *= 2
-			if digit > 9 {
-				digit -= 9
+// BankCardInfo describes the issuer of a bank card number.
+type BankCardInfo struct {
+	BIN         string   // matched BIN prefix
+	Bank        string   // issuing bank
+	CardType    CardType // card type
+	Length      int      // expected card number length for the BIN
+	Closed      bool     // the bank has closed or merged and no longer issues the BIN
+	LengthValid bool     // the card number has the expected length
+	LUHNValid   bool     // the card number passes the LUHN check
+}
+
+type cardBinEntry struct {
+	bank   *metadata.CardBin
+	closed bool
+}
+
+var cardBinIndex, cardBinMinLen, cardBinMaxLen = buildCardBinIndex()
+
+// buildCardBinIndex indexes metadata.CardBins and metadata.ClosedCardBins by prefix
+// and returns the shortest and longest prefix length.
+func buildCardBinIndex() (map[string]cardBinEntry, int, int) {
+	index := make(map[string]cardBinEntry)
+	minLen, maxLen := 0, 0
+	add := func(bins []metadata.CardBin, closed bool) {
+		for i := range bins {
+			for _, prefix := range bins[i].Prefixes {
+				key := strconv.Itoa(prefix)
+				if _, ok := index[key]; ok {
+					continue
+				}
+				index[key] = cardBinEntry{bank: &bins[i], closed: closed}
+				if minLen == 0 || len(key) < minLen {
+					minLen = len(key)
+				}
+				maxLen = max(maxLen, len(key))
 			}
 		}
-		sum += digit
 	}
-	return sum%10 == 0
+	add(metadata.CardBins, false)
+	add(metadata.ClosedCardBins, true)
+	return index, minLen, maxLen
+}
+
+// lookupCardBin returns the longest BIN prefix of cardNo and its entry.
+func lookupCardBin(cardNo string) (string, cardBinEntry, bool) {
+	for l := min(len(cardNo), cardBinMaxLen); l >= cardBinMinLen; l-- {
+		if entry, ok := cardBinIndex[cardNo[:l]]; ok {
+			return cardNo[:l], entry, true
+		}
+	}
+	return "", cardBinEntry{}, false
+}
+
+// isKnownBIN reports whether bin starts with a prefix from metadata.CardBins or metadata.ClosedCardBins.
+func isKnownBIN(bin string) bool {
+	_, _, ok := lookupCardBin(bin)
+	return ok
+}
+
+// LookupBankCard identifies the issuing bank of a card number by longest-prefix
+// matching over metadata.CardBins. BINs of closed banks are reported with Closed set.
+// It returns a *ValidationError wrapping ErrBankNoLength, ErrBankNoFormat or
+// ErrBankNoUnknownBIN if the number is empty, not all digits or matches no BIN.
+func LookupBankCard(cardNo string) (*BankCardInfo, error) {
+	if cardNo == "" {
+		return nil, bankNoError(ErrBankNoLength, -1)
+	}
+	for i := 0; i < len(cardNo); i++ {
+		if !isDigit(cardNo[i]) {
+			return nil, bankNoError(ErrBankNoFormat, i)
+		}
+	}
+
+	bin, entry, ok := lookupCardBin(cardNo)
+	if !ok {
+		return nil, bankNoError(ErrBankNoUnknownBIN, 0)
+	}
+
+	return &BankCardInfo{
+		BIN:         bin,
+		Bank:        entry.bank.Name,
+		CardType:    parseCardType(entry.bank.CardType),
+		Length:      entry.bank.Length,
+		Closed:      entry.closed,
+		LengthValid: len(cardNo) == entry.bank.Length,
+		LUHNValid:   ValidateLUHN(cardNo),
+	}, nil
+}
+
+func bankNoError(err error, pos int) *ValidationError {
+	return &ValidationError{Field: FieldBankNo, Position: pos, Err: err}
+}
+
+// CheckBankNo validates the length, characters and LUHN check digit of a bank card number.
+// The returned error is a *ValidationError wrapping one of the ErrBankNo* errors.
+func CheckBankNo(cardNo string) error {
+	if len(cardNo) < 13 || len(cardNo) > 19 {
+		return bankNoError(ErrBankNoLength, -1)
+	}
+	for i := 0; i < len(cardNo); i++ {
+		if !isDigit(cardNo[i]) {
+			return bankNoError(ErrBankNoFormat, i)
+		}
+	}
+
+	last := len(cardNo) - 1
+	expected := calculateLUHNCheckDigit(cardNo[:last])
+	if int(cardNo[last]-'0') != expected {
+		return &ValidationError{
+			Field:    FieldBankNo,
+			Position: last,
+			Expected: strconv.Itoa(expected),
+			Err:      ErrBankNoChecksum,
+		}
+	}
+	return nil
+}
+
+// ValidateLUHN validates a bank card number using the LUHN algorithm.
+func ValidateLUHN(cardNo string) bool {
+	return CheckBankNo(cardNo) == nil
 }
@@ -0,0 +1,119 @@
+package chinaid
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+func TestCheckBankNo(t *testing.T) {
+	payload := "622202123456789012"
+	valid := payload + strconv.Itoa(calculateLUHNCheckDigit(payload))
+	if err := CheckBankNo(valid); err != nil {
+		t.Fatalf("CheckBankNo(%s) = %v, want nil", valid, err)
+	}
+
+	wrongDigit := byte('0' + (valid[18]-'0'+1)%10)
+	wrong := valid[:18] + string(wrongDigit)
+
+	tests := []struct {
+		name   string
+		cardNo string
+		want   error
+		pos    int
+	}{
+		{"short", "622202123456", ErrBankNoLength, -1},
+		{"long", "62220212345678901234", ErrBankNoLength, -1},
+		{"non-digit", "62220212345678A0123", ErrBankNoFormat, 14},
+		{"checksum", wrong, ErrBankNoChecksum, 18},
+	}
+
+	for _, tt := range tests {
+		err := CheckBankNo(tt.cardNo)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: CheckBankNo(%s) error = %v, want %v", tt.name, tt.cardNo, err, tt.want)
+			continue
+		}
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%s: error should be *ValidationError, got %T", tt.name, err)
+			continue
+		}
+		if verr.Field != FieldBankNo || verr.Position != tt.pos {
+			t.Errorf("%s: ValidationError = %+v, want position %d", tt.name, verr, tt.pos)
+		}
+	}
+
+	var verr *ValidationError
+	if errors.As(CheckBankNo(wrong), &verr) && verr.Expected != valid[18:] {
+		t.Errorf("Expected = %s, want %s", verr.Expected, valid[18:])
+	}
+}
+
+func TestValidateLUHN(t *testing.T) {
+	tests := []struct {
+		cardNo string
+		want   bool
+	}{
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"411111111111111a", false},
+		{"411111111111", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateLUHN(tt.cardNo); got != tt.want {
+			t.Errorf("ValidateLUHN(%s) = %v, want %v", tt.cardNo, got, tt.want)
+		}
+	}
+}
+
+func TestLookupBankCard(t *testing.T) {
+	payload := "622202123456789012"
+	cardNo := payload + strconv.Itoa(calculateLUHNCheckDigit(payload))
+
+	info, err := LookupBankCard(cardNo)
+	if err != nil {
+		t.Fatalf("LookupBankCard(%s) returned error: %v", cardNo, err)
+	}
+	if info.BIN != "622202" || info.Bank != "工商银行" || info.CardType != CardTypeDebit {
+		t.Errorf("LookupBankCard(%s) = %+v, want 622202/工商银行/借记卡", cardNo, info)
+	}
+	if info.Length != 19 || !info.LengthValid || !info.LUHNValid || info.Closed {
+		t.Errorf("LookupBankCard(%s) = %+v, want valid 19-digit card", cardNo, info)
+	}
+
+	info, err = LookupBankCard(cardNo[:16])
+	if err != nil || info.LengthValid {
+		t.Errorf("LookupBankCard(%s) = %+v, %v, want length mismatch", cardNo[:16], info, err)
+	}
+
+	if _, err := LookupBankCard("1234567890123456"); !errors.Is(err, ErrBankNoUnknownBIN) {
+		t.Errorf("LookupBankCard unknown BIN error = %v, want ErrBankNoUnknownBIN", err)
+	}
+	if _, err := LookupBankCard("6222-0212345678"); !errors.Is(err, ErrBankNoFormat) {
+		t.Errorf("LookupBankCard non-digit error = %v, want ErrBankNoFormat", err)
+	}
+}
+
+func TestLookupBankCardGenerated(t *testing.T) {
+	for _, p := range NewPerson().BuildN(100) {
+		info, err := LookupBankCard(p.BankNo())
+		if err != nil {
+			t.Fatalf("LookupBankCard(%s) returned error: %v", p.BankNo(), err)
+		}
+		if !info.LengthValid || !info.LUHNValid || info.Closed {
+			t.Errorf("LookupBankCard(%s) = %+v, want valid card", p.BankNo(), info)
+		}
+	}
+}
+
+func TestCardBinsCardType(t *testing.T) {
+	for _, bank := range metadata.CardBins {
+		if parseCardType(bank.CardType) == CardTypeRandom {
+			t.Errorf("%s has unknown card type %q", bank.Name, bank.CardType)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package chinaid
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Field names reported in ValidationError.
+const (
+	FieldIDNo     = "id_no"
+	FieldBankNo   = "bank_no"
+	FieldMobile   = "mobile"
+	FieldLandline = "landline"
+)
+
+// ID number errors returned by CheckIDNo, CheckIDNo15 and ParseIDNo.
+var (
+	ErrIDNoLength         = errors.New("chinaid: invalid ID number length")
+	ErrIDNoFormat         = errors.New("chinaid: ID number contains invalid characters")
+	ErrIDNoAreaCode       = errors.New("chinaid: unknown area code")
+	ErrIDNoBirthday       = errors.New("chinaid: invalid birthday")
+	ErrIDNoFutureBirthday = errors.New("chinaid: birthday is in the future")
+	ErrIDNoCheckCode      = errors.New("chinaid: check code mismatch")
+	ErrIDNoCentury        = errors.New("chinaid: birth year has no 15-digit ID number form")
+)
+
+// Lunar calendar errors returned by SolarToLunar and LunarToSolar.
+var (
+	ErrLunarRange = errors.New("chinaid: date outside the supported lunar calendar range")
+	ErrLunarDate  = errors.New("chinaid: lunar date does not exist")
+)
+
+// ErrRegionNotFound is returned by LookupRegion when no region has the given code.
+var ErrRegionNotFound = errors.New("chinaid: region not found")
+
+// ErrUnsatisfiable is returned by PersonBuilder.TryBuild when the builder
+// options cannot be satisfied.
+var ErrUnsatisfiable = errors.New("chinaid: unsatisfiable builder options")
+
+// Bank card number errors returned by CheckBankNo and LookupBankCard.
+var (
+	ErrBankNoLength     = errors.New("chinaid: invalid bank card number length")
+	ErrBankNoFormat     = errors.New("chinaid: bank card number contains invalid characters")
+	ErrBankNoChecksum   = errors.New("chinaid: bank card number fails LUHN check")
+	ErrBankNoUnknownBIN = errors.New("chinaid: unknown bank card BIN")
+)
+
+// Mobile number errors returned by ParseMobile.
+var (
+	ErrMobileLength  = errors.New("chinaid: invalid mobile number length")
+	ErrMobileFormat  = errors.New("chinaid: mobile number contains invalid characters")
+	ErrMobileSegment = errors.New("chinaid: unknown mobile number segment")
+)
+
+// Landline number errors returned by ParseLandline and CheckLandline.
+var (
+	ErrLandlineFormat   = errors.New("chinaid: landline number contains invalid characters")
+	ErrLandlineAreaCode = errors.New("chinaid: unknown landline area code")
+	ErrLandlineNumber   = errors.New("chinaid: invalid landline local number")
+	ErrLandlineCity     = errors.New("chinaid: landline area code does not belong to the city")
+)
+
+// ValidationError describes why a value was rejected.
+// Use errors.Is with the sentinel errors above to test the reason.
+type ValidationError struct {
+	Field    string // FieldIDNo, FieldBankNo, FieldMobile or FieldLandline
+	Position int    // 0-based index of the offending character, -1 if not applicable
+	Expected string // expected check digit, empty if not applicable
+	Err      error  // underlying sentinel error
+}
+
+func (e *ValidationError) Error() string {
+	msg := e.Err.Error()
+	if e.Position >= 0 {
+		msg += fmt.Sprintf(" at position %d", e.Position)
+	}
+	if e.Expected != "" {
+		msg += fmt.Sprintf(" (expected %s)", e.Expected)
+	}
+	return msg
+}
+
+func (e *ValidationError) Unwrap() error { return e.Err }
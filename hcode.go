@@ -0,0 +1,78 @@
+package chinaid
+
+import (
+	"sort"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// hCodeRange is a block of H-codes (the 4 digits after the 3-digit network
+// prefix) of a segment allocated to a prefecture-level city.
+type hCodeRange struct {
+	prefix   string // segment prefix, as in metadata.MobileSegment
+	from, to int    // inclusive H-code range, "0000" being 0
+	city     string // city code, e.g. "330100"
+}
+
+// hCodeRanges maps segment prefixes to their H-code blocks in H-code order;
+// cityHCodeRanges maps a prefix and city code to the block.
+//
+// The allocation is simulated, not the carriers' real data: the H-codes of
+// each segment (0000-9999, or the 1000 starting with the 4th digit of a
+// 4-digit prefix) are handed out to the cities in code order, at least one
+// each and the rest by census population. It keeps generated numbers
+// consistent with the person's city but says nothing about where real
+// numbers are registered.
+var hCodeRanges, cityHCodeRanges = func() (map[string][]*hCodeRange, map[[2]string]*hCodeRange) {
+	var cities []*metadata.City
+	for i := range metadata.Provinces {
+		for j := range metadata.Provinces[i].Cities {
+			cities = append(cities, &metadata.Provinces[i].Cities[j])
+		}
+	}
+	sort.Slice(cities, func(i, j int) bool { return cities[i].Code < cities[j].Code })
+
+	bySegment := make(map[string][]*hCodeRange)
+	byCity := make(map[[2]string]*hCodeRange)
+	for _, s := range metadata.MobileSegments {
+		from, size := 0, 10000
+		if len(s.Prefix) == 4 {
+			from, size = int(s.Prefix[3]-'0')*1000, 1000
+		}
+		for i, n := range allocateHCodes(cities, size) {
+			h := &hCodeRange{prefix: s.Prefix, from: from, to: from + n - 1, city: cities[i].Code}
+			bySegment[s.Prefix] = append(bySegment[s.Prefix], h)
+			byCity[[2]string{s.Prefix, h.city}] = h
+			from += n
+		}
+	}
+	return bySegment, byCity
+}()
+
+// allocateHCodes splits size H-codes among cities: one each, the rest by
+// population with the largest remainder method.
+func allocateHCodes(cities []*metadata.City, size int) []int {
+	total := 0
+	for _, c := range cities {
+		total += c.Population
+	}
+	rest := size - len(cities)
+	counts := make([]int, len(cities))
+	remainders := make([]int, len(cities))
+	assigned := 0
+	for i, c := range cities {
+		counts[i] = 1 + rest*c.Population/total
+		remainders[i] = rest * c.Population % total
+		assigned += counts[i] - 1
+	}
+
+	order := make([]int, len(cities))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for _, i := range order[:rest-assigned] {
+		counts[i]++
+	}
+	return counts
+}
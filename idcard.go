@@ -1,8 +1,33 @@
 package chinaid
 
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// chinaLocation is China Standard Time (UTC+8). Birthdays are calendar dates
+// in this zone regardless of the local time zone.
+var chinaLocation = time.FixedZone("CST", 8*60*60)
+
 var idCardWeights = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
 var idCardCheckCodes = []string{"1", "0", "X", "9", "8", "7", "6", "5", "4", "3", "2"}
 
+// IDInfo holds the fields encoded in an 18-digit ID number.
+type IDInfo struct {
+	AreaCode   string    // 6-digit area code
+	Province   string    // province short name
+	City       string    // prefecture-level city name
+	District   string    // county-level district name, empty for a city-level code
+	Historical bool      // the area code has been retired; District is its former name
+	Birthday   time.Time // date of birth, midnight China Standard Time
+	SeqCode    string    // 3-digit sequence code
+	Gender     Gender    // derived from sequence code parity
+	CheckCode  string    // check code, "X" is always uppercase
+	Age        int       // age at the time of parsing, in China Standard Time
+}
+
 func calculateCheckCode(idNo17 string) string {
 	sum := 0
 	for i, w := range idCardWeights {
@@ -11,23 +36,246 @@ func calculateCheckCode(idNo17 string) string {
 	return idCardCheckCodes[sum%11]
 }
 
-// ValidateIDNo validates whether the ID number is valid.
-func ValidateIDNo(idNo string) bool {
-	if len(idNo) != 18 {
-		return false
+// calculateAge returns the age in full years on the given date.
+func calculateAge(birthday, now time.Time) int {
+	age := now.Year() - birthday.Year()
+	if now.Month() < birthday.Month() ||
+		(now.Month() == birthday.Month() && now.Day() < birthday.Day()) {
+		age--
 	}
+	return age
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func idNoError(err error, pos int) *ValidationError {
+	return &ValidationError{Field: FieldIDNo, Position: pos, Err: err}
+}
 
-	for i := 0; i < 17; i++ {
-		if idNo[i] < '0' || idNo[i] > '9' {
-			return false
+// checkIDNoChars checks that idNo has n characters, all ASCII digits except a
+// trailing check code that may be X. Positions are counted in characters, so
+// full-width digits are reported as invalid characters rather than a bad length.
+func checkIDNoChars(idNo string, n int, hasCheckCode bool) error {
+	if utf8.RuneCountInString(idNo) != n {
+		return idNoError(ErrIDNoLength, -1)
+	}
+	i := 0
+	for _, r := range idNo {
+		ok := r >= '0' && r <= '9'
+		if hasCheckCode && i == n-1 {
+			ok = ok || r == 'X' || r == 'x'
+		}
+		if !ok {
+			return idNoError(ErrIDNoFormat, i)
 		}
+		i++
+	}
+	return nil
+}
+
+// checkIDNoFormat checks the length and the character set of an 18-digit ID number.
+func checkIDNoFormat(idNo string) error {
+	return checkIDNoChars(idNo, 18, true)
+}
+
+// checkIDNoCheckCode compares the check code with the one computed from the first 17 digits.
+func checkIDNoCheckCode(idNo string) error {
+	expected := calculateCheckCode(idNo[:17])
+	actual := idNo[17:]
+	if actual == "x" {
+		actual = "X"
+	}
+	if expected != actual {
+		return &ValidationError{Field: FieldIDNo, Position: 17, Expected: expected, Err: ErrIDNoCheckCode}
+	}
+	return nil
+}
+
+// ValidateOption configures the checks performed by CheckIDNo and ValidateIDNo.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	areaCode   bool
+	birthday   bool
+	uppercaseX bool
+}
+
+// AreaCodeCheck requires the first six digits to be a known area code, current
+// or retired.
+func AreaCodeCheck() ValidateOption {
+	return func(o *validateOptions) { o.areaCode = true }
+}
+
+// BirthdayCheck requires the embedded birthday to be a real calendar date
+// that is not in the future.
+func BirthdayCheck() ValidateOption {
+	return func(o *validateOptions) { o.birthday = true }
+}
+
+// UppercaseX rejects a lowercase x check code; GB 11643 only allows uppercase X.
+func UppercaseX() ValidateOption {
+	return func(o *validateOptions) { o.uppercaseX = true }
+}
+
+// Strict enables the area code and birthday checks on top of the check code.
+// It does not reject a lowercase x; add UppercaseX for that.
+func Strict() ValidateOption {
+	return func(o *validateOptions) {
+		o.areaCode = true
+		o.birthday = true
+	}
+}
+
+// checkIDNo15Format checks the length and the character set of a 15-digit ID number.
+func checkIDNo15Format(idNo string) error {
+	return checkIDNoChars(idNo, 15, false)
+}
+
+// checkIDNoAreaCode looks up the first six digits in metadata.AreaCodeMap,
+// which also holds the retired area codes.
+func checkIDNoAreaCode(idNo string) error {
+	if _, ok := metadata.AreaCodeMap[idNo[:6]]; !ok {
+		return idNoError(ErrIDNoAreaCode, 0)
+	}
+	return nil
+}
+
+// checkIDNoBirthday checks that the 8-digit birthday exists and is not after now.
+func checkIDNoBirthday(date string, now time.Time) error {
+	birthday, err := time.ParseInLocation("20060102", date, chinaLocation)
+	if err != nil {
+		return idNoError(ErrIDNoBirthday, 6)
+	}
+	if birthday.After(now) {
+		return idNoError(ErrIDNoFutureBirthday, 6)
+	}
+	return nil
+}
+
+// CheckIDNo validates an ID number. By default only the length, characters and
+// check code are verified; pass Strict() or individual options for more checks.
+// The returned error is a *ValidationError wrapping one of the ErrIDNo* errors.
+func CheckIDNo(idNo string, opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := checkIDNoFormat(idNo); err != nil {
+		return err
+	}
+	if o.uppercaseX && idNo[17] == 'x' {
+		return idNoError(ErrIDNoFormat, 17)
+	}
+	if o.areaCode {
+		if err := checkIDNoAreaCode(idNo); err != nil {
+			return err
+		}
+	}
+	if o.birthday {
+		if err := checkIDNoBirthday(idNo[6:14], time.Now()); err != nil {
+			return err
+		}
+	}
+	return checkIDNoCheckCode(idNo)
+}
+
+// CheckIDNo15 validates a 15-digit first-generation ID number, which has a
+// YYMMDD birthday in the 20th century and no check code.
+// It accepts the same options as CheckIDNo.
+func CheckIDNo15(idNo string, opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := checkIDNo15Format(idNo); err != nil {
+		return err
+	}
+	if o.areaCode {
+		if err := checkIDNoAreaCode(idNo); err != nil {
+			return err
+		}
+	}
+	if o.birthday {
+		if err := checkIDNoBirthday("19"+idNo[6:12], time.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateIDNo15 validates whether the 15-digit ID number is valid.
+// See CheckIDNo15 for the available options.
+func ValidateIDNo15(idNo string, opts ...ValidateOption) bool {
+	return CheckIDNo15(idNo, opts...) == nil
+}
+
+// ConvertIDNo15To18 upgrades a 15-digit ID number to 18 digits by inserting
+// the "19" century and appending the check code.
+func ConvertIDNo15To18(idNo string) (string, error) {
+	if err := checkIDNo15Format(idNo); err != nil {
+		return "", err
+	}
+	idNo17 := idNo[:6] + "19" + idNo[6:]
+	return idNo17 + calculateCheckCode(idNo17), nil
+}
+
+// ConvertIDNo18To15 downgrades an 18-digit ID number to the 15-digit form.
+// Only numbers with a birth year in 1900-1999 have a 15-digit form.
+func ConvertIDNo18To15(idNo string) (string, error) {
+	if err := CheckIDNo(idNo); err != nil {
+		return "", err
+	}
+	if idNo[6:8] != "19" {
+		return "", idNoError(ErrIDNoCentury, 6)
+	}
+	return toIDNo15(idNo), nil
+}
+
+// toIDNo15 drops the century digits and the check code.
+func toIDNo15(idNo string) string {
+	return idNo[:6] + idNo[8:17]
+}
+
+// ValidateIDNo validates whether the ID number is valid.
+// See CheckIDNo for the available options.
+func ValidateIDNo(idNo string, opts ...ValidateOption) bool {
+	return CheckIDNo(idNo, opts...) == nil
+}
+
+// ParseIDNo parses an 18-digit ID number into its fields.
+// The number is validated as with CheckIDNo(idNo, AreaCodeCheck(), BirthdayCheck()).
+func ParseIDNo(idNo string) (*IDInfo, error) {
+	if err := CheckIDNo(idNo, AreaCodeCheck(), BirthdayCheck()); err != nil {
+		return nil, err
+	}
+
+	area := metadata.AreaCodeMap[idNo[:6]]
+	birthday, _ := time.ParseInLocation("20060102", idNo[6:14], chinaLocation)
+
+	gender := GenderFemale
+	if int(idNo[16]-'0')%2 == 1 {
+		gender = GenderMale
 	}
 
-	expectedCheck := calculateCheckCode(idNo[:17])
-	actualCheck := string(idNo[17])
-	if actualCheck == "x" {
-		actualCheck = "X"
+	checkCode := idNo[17:]
+	if checkCode == "x" {
+		checkCode = "X"
 	}
 
-	return expectedCheck == actualCheck
+	return &IDInfo{
+		AreaCode:   idNo[:6],
+		Province:   area.Province,
+		City:       area.City,
+		District:   area.District,
+		Historical: area.Historical,
+		Birthday:   birthday,
+		SeqCode:    idNo[14:17],
+		Gender:     gender,
+		CheckCode:  checkCode,
+		Age:        calculateAge(birthday, time.Now().In(chinaLocation)),
+	}, nil
 }
@@ -0,0 +1,245 @@
+package chinaid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func withCheckCode(idNo17 string) string {
+	return idNo17 + calculateCheckCode(idNo17)
+}
+
+func TestParseIDNo(t *testing.T) {
+	idNo := withCheckCode("11010519900307123")
+	info, err := ParseIDNo(idNo)
+	if err != nil {
+		t.Fatalf("ParseIDNo(%s) returned error: %v", idNo, err)
+	}
+
+	if info.AreaCode != "110105" {
+		t.Errorf("AreaCode = %s, want 110105", info.AreaCode)
+	}
+	if info.Province != "北京" || info.City != "北京市" || info.District != "朝阳区" {
+		t.Errorf("Province/City/District = %s/%s/%s, want 北京/北京市/朝阳区", info.Province, info.City, info.District)
+	}
+	if info.Historical {
+		t.Error("Historical = true, want false")
+	}
+	if info.Birthday.Format("2006-01-02") != "1990-03-07" {
+		t.Errorf("Birthday = %s, want 1990-03-07", info.Birthday.Format("2006-01-02"))
+	}
+	if info.SeqCode != "123" {
+		t.Errorf("SeqCode = %s, want 123", info.SeqCode)
+	}
+	if info.Gender != GenderMale {
+		t.Errorf("Gender = %v, want male", info.Gender)
+	}
+	if info.CheckCode != idNo[17:] {
+		t.Errorf("CheckCode = %s, want %s", info.CheckCode, idNo[17:])
+	}
+	if want := calculateAge(info.Birthday, time.Now()); info.Age != want {
+		t.Errorf("Age = %d, want %d", info.Age, want)
+	}
+}
+
+func TestParseIDNoHistorical(t *testing.T) {
+	tests := []struct {
+		idNo17   string
+		city     string
+		district string
+	}{
+		{"33012519850101001", "杭州市", "余杭县"},
+		{"11022319700101001", "北京市", "通县"},
+		{"31022519950101001", "上海市", "南汇县"},
+		{"51021219800101001", "重庆市", "沙坪坝区"},
+	}
+	for _, tt := range tests {
+		idNo := withCheckCode(tt.idNo17)
+		if err := CheckIDNo(idNo, AreaCodeCheck()); err != nil {
+			t.Errorf("CheckIDNo(%s, AreaCodeCheck()) = %v, want nil", idNo, err)
+		}
+		info, err := ParseIDNo(idNo)
+		if err != nil {
+			t.Fatalf("ParseIDNo(%s) returned error: %v", idNo, err)
+		}
+		if !info.Historical || info.City != tt.city || info.District != tt.district {
+			t.Errorf("ParseIDNo(%s): Historical/City/District = %v/%s/%s, want true/%s/%s",
+				idNo, info.Historical, info.City, info.District, tt.city, tt.district)
+		}
+	}
+}
+
+func TestParseIDNoErrors(t *testing.T) {
+	valid := withCheckCode("11010519900307122")
+	wrongCheck := valid[:17] + "0"
+	if wrongCheck == valid {
+		wrongCheck = valid[:17] + "1"
+	}
+
+	tests := []struct {
+		name string
+		idNo string
+		want error
+	}{
+		{"short", "1101051990030712", ErrIDNoLength},
+		{"non-digit", "11010519900307A2" + "X" + "1", ErrIDNoFormat},
+		{"bad check char", valid[:17] + "Y", ErrIDNoFormat},
+		{"unknown area", withCheckCode("99999919900307122"), ErrIDNoAreaCode},
+		{"month 13", withCheckCode("11010519901307122"), ErrIDNoBirthday},
+		{"feb 30", withCheckCode("11010519900230122"), ErrIDNoBirthday},
+		{"future", withCheckCode("11010521990307122"), ErrIDNoFutureBirthday},
+		{"check code", wrongCheck, ErrIDNoCheckCode},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseIDNo(tt.idNo); !errors.Is(err, tt.want) {
+			t.Errorf("%s: ParseIDNo(%s) error = %v, want %v", tt.name, tt.idNo, err, tt.want)
+		}
+	}
+}
+
+func TestParseIDNoGeneratedPerson(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		p := NewPerson().Build()
+		info, err := ParseIDNo(p.IDNo())
+		if err != nil {
+			t.Fatalf("ParseIDNo(%s) returned error: %v", p.IDNo(), err)
+		}
+		if info.Gender != p.Gender() {
+			t.Errorf("Gender = %v, want %v", info.Gender, p.Gender())
+		}
+		if info.AreaCode != p.AreaCode() {
+			t.Errorf("AreaCode = %s, want %s", info.AreaCode, p.AreaCode())
+		}
+	}
+}
+
+func TestCalculateAge(t *testing.T) {
+	birthday := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		now  time.Time
+		want int
+	}{
+		{time.Date(2018, 2, 28, 0, 0, 0, 0, time.UTC), 17},
+		{time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), 18},
+		{time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), 20},
+	}
+
+	for _, tt := range tests {
+		if got := calculateAge(birthday, tt.now); got != tt.want {
+			t.Errorf("calculateAge(%s) = %d, want %d", tt.now.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestCheckIDNo(t *testing.T) {
+	valid := withCheckCode("11010519900307122")
+	if err := CheckIDNo(valid); err != nil {
+		t.Errorf("CheckIDNo(%s) = %v, want nil", valid, err)
+	}
+
+	wrong := valid[:17] + "0"
+	if wrong == valid {
+		wrong = valid[:17] + "1"
+	}
+	err := CheckIDNo(wrong)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("CheckIDNo(%s) error = %v, want *ValidationError", wrong, err)
+	}
+	if !errors.Is(err, ErrIDNoCheckCode) {
+		t.Errorf("error = %v, want ErrIDNoCheckCode", err)
+	}
+	if verr.Field != FieldIDNo || verr.Position != 17 || verr.Expected != valid[17:] {
+		t.Errorf("ValidationError = %+v, want field %s, position 17, expected %s",
+			verr, FieldIDNo, valid[17:])
+	}
+
+	err = CheckIDNo("1101051990A307122X")
+	if !errors.As(err, &verr) || !errors.Is(err, ErrIDNoFormat) || verr.Position != 10 {
+		t.Errorf("CheckIDNo non-digit error = %v, want ErrIDNoFormat at position 10", err)
+	}
+
+	if err := CheckIDNo("110105"); !errors.Is(err, ErrIDNoLength) {
+		t.Errorf("CheckIDNo short error = %v, want ErrIDNoLength", err)
+	}
+}
+
+func TestValidateIDNoStrict(t *testing.T) {
+	tests := []struct {
+		name   string
+		idNo   string
+		loose  bool
+		strict bool
+	}{
+		{"valid", withCheckCode("11010519900307122"), true, true},
+		{"leap day", withCheckCode("11010520000229122"), true, true},
+		{"non-leap feb 29", withCheckCode("11010519990229122"), true, false},
+		{"month 13 day 45", withCheckCode("11010119901345123"), true, false},
+		{"unknown area", withCheckCode("99999919900307122"), true, false},
+		{"future", withCheckCode("11010521990307122"), true, false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateIDNo(tt.idNo); got != tt.loose {
+			t.Errorf("%s: ValidateIDNo(%s) = %v, want %v", tt.name, tt.idNo, got, tt.loose)
+		}
+		if got := ValidateIDNo(tt.idNo, Strict()); got != tt.strict {
+			t.Errorf("%s: ValidateIDNo(%s, Strict()) = %v, want %v", tt.name, tt.idNo, got, tt.strict)
+		}
+	}
+
+	unknownArea := withCheckCode("99999919900307122")
+	if !ValidateIDNo(unknownArea, BirthdayCheck()) {
+		t.Errorf("BirthdayCheck should not check the area code of %s", unknownArea)
+	}
+	if err := CheckIDNo(unknownArea, AreaCodeCheck()); !errors.Is(err, ErrIDNoAreaCode) {
+		t.Errorf("CheckIDNo(%s, AreaCodeCheck()) = %v, want ErrIDNoAreaCode", unknownArea, err)
+	}
+
+	lowercaseX := "11010519900307101x"
+	if !ValidateIDNo(lowercaseX, Strict()) {
+		t.Errorf("Strict should accept the lowercase x of %s", lowercaseX)
+	}
+	if err := CheckIDNo(lowercaseX, Strict(), UppercaseX()); !errors.Is(err, ErrIDNoFormat) {
+		t.Errorf("CheckIDNo(%s, Strict(), UppercaseX()) = %v, want ErrIDNoFormat", lowercaseX, err)
+	}
+	if !ValidateIDNo(strings.ToUpper(lowercaseX), Strict(), UppercaseX()) {
+		t.Errorf("UppercaseX should accept %s", strings.ToUpper(lowercaseX))
+	}
+}
+
+func TestIDNo15(t *testing.T) {
+	id15 := "110105900307122"
+	if !ValidateIDNo15(id15, Strict()) {
+		t.Errorf("ValidateIDNo15(%s, Strict()) should be true", id15)
+	}
+	if ValidateIDNo15("11010590030712") || ValidateIDNo15("11010590030712X") {
+		t.Error("ValidateIDNo15 should reject bad length and non-digits")
+	}
+	if err := CheckIDNo15("110105901307122", Strict()); !errors.Is(err, ErrIDNoBirthday) {
+		t.Errorf("CheckIDNo15 bad month error = %v, want ErrIDNoBirthday", err)
+	}
+
+	id18, err := ConvertIDNo15To18(id15)
+	if err != nil {
+		t.Fatalf("ConvertIDNo15To18(%s) returned error: %v", id15, err)
+	}
+	if want := withCheckCode("11010519900307122"); id18 != want {
+		t.Errorf("ConvertIDNo15To18(%s) = %s, want %s", id15, id18, want)
+	}
+
+	back, err := ConvertIDNo18To15(id18)
+	if err != nil {
+		t.Fatalf("ConvertIDNo18To15(%s) returned error: %v", id18, err)
+	}
+	if back != id15 {
+		t.Errorf("ConvertIDNo18To15(%s) = %s, want %s", id18, back, id15)
+	}
+
+	if _, err := ConvertIDNo18To15(withCheckCode("11010520000307122")); !errors.Is(err, ErrIDNoCentury) {
+		t.Errorf("ConvertIDNo18To15 for 2000 birth error = %v, want ErrIDNoCentury", err)
+	}
+}
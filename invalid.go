@@ -0,0 +1,385 @@
+package chinaid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// IDNoDefect describes how a generated ID number is invalid.
+type IDNoDefect int
+
+const (
+	IDDefectRandom     IDNoDefect = iota // any of the defects below
+	IDDefectCheckCode                    // wrong check code
+	IDDefectBirthday                     // impossible birthday, e.g. Feb 30
+	IDDefectAreaCode                     // unknown area code
+	IDDefectLength                       // one digit too many or too few
+	IDDefectLowercaseX                   // check code x in lowercase
+	IDDefectMisplacedX                   // X in place of one of the first 17 digits
+	IDDefectFullWidth                    // full-width digits, e.g. "１１０"
+	IDDefectWhitespace                   // whitespace inside the number
+)
+
+var idNoDefects = []IDNoDefect{
+	IDDefectCheckCode,
+	IDDefectBirthday,
+	IDDefectAreaCode,
+	IDDefectLength,
+	IDDefectLowercaseX,
+	IDDefectMisplacedX,
+	IDDefectFullWidth,
+	IDDefectWhitespace,
+}
+
+// String returns the label of the defect.
+func (d IDNoDefect) String() string {
+	switch d {
+	case IDDefectCheckCode:
+		return "check_code"
+	case IDDefectBirthday:
+		return "birthday"
+	case IDDefectAreaCode:
+		return "area_code"
+	case IDDefectLength:
+		return "length"
+	case IDDefectLowercaseX:
+		return "lowercase_x"
+	case IDDefectMisplacedX:
+		return "misplaced_x"
+	case IDDefectFullWidth:
+		return "full_width"
+	case IDDefectWhitespace:
+		return "whitespace"
+	default:
+		return "random"
+	}
+}
+
+// Err returns the error that CheckIDNo(idNo, Strict(), UppercaseX()) reports
+// for the defect.
+func (d IDNoDefect) Err() error {
+	switch d {
+	case IDDefectCheckCode:
+		return ErrIDNoCheckCode
+	case IDDefectBirthday:
+		return ErrIDNoBirthday
+	case IDDefectAreaCode:
+		return ErrIDNoAreaCode
+	case IDDefectLength, IDDefectWhitespace:
+		return ErrIDNoLength
+	case IDDefectLowercaseX, IDDefectMisplacedX, IDDefectFullWidth:
+		return ErrIDNoFormat
+	default:
+		return nil
+	}
+}
+
+// InvalidIDNo is a deliberately invalid ID number and the defect it carries.
+type InvalidIDNo struct {
+	IDNo   string
+	Defect IDNoDefect
+}
+
+// InvalidIDNoBuilder is a builder for deliberately invalid ID numbers.
+type InvalidIDNoBuilder struct {
+	rng     *Rng
+	seed    int64
+	hasSeed bool
+	defects []IDNoDefect
+}
+
+// NewInvalidIDNo creates a new InvalidIDNoBuilder.
+func NewInvalidIDNo() *InvalidIDNoBuilder {
+	return &InvalidIDNoBuilder{}
+}
+
+// Defect restricts the generated defects to the given ones.
+// By default any defect may be generated.
+func (b *InvalidIDNoBuilder) Defect(defects ...IDNoDefect) *InvalidIDNoBuilder {
+	b.defects = defects
+	return b
+}
+
+// Seed sets the random seed for reproducibility.
+func (b *InvalidIDNoBuilder) Seed(seed int64) *InvalidIDNoBuilder {
+	b.seed = seed
+	b.hasSeed = true
+	return b
+}
+
+// Build generates a single invalid ID number.
+func (b *InvalidIDNoBuilder) Build() InvalidIDNo {
+	if b.hasSeed {
+		b.rng = NewRngWithSeed(b.seed)
+	} else {
+		b.rng = NewRng()
+	}
+
+	defect := IDDefectRandom
+	if len(b.defects) > 0 {
+		defect = b.defects[b.rng.Intn(len(b.defects))]
+	}
+	if defect == IDDefectRandom {
+		defect = idNoDefects[b.rng.Intn(len(idNoDefects))]
+	}
+
+	return InvalidIDNo{IDNo: b.generate(defect), Defect: defect}
+}
+
+// BuildN generates multiple invalid ID numbers.
+func (b *InvalidIDNoBuilder) BuildN(n int) []InvalidIDNo {
+	ids := make([]InvalidIDNo, n)
+	for i := 0; i < n; i++ {
+		builder := *b
+		if b.hasSeed {
+			builder.seed = b.seed + int64(i)
+		}
+		ids[i] = builder.Build()
+	}
+	return ids
+}
+
+// validIDNo generates a valid 18-digit ID number using the person generators,
+// in the order of TryBuild so that the area code is one in use in the birth year.
+func (b *InvalidIDNoBuilder) validIDNo() string {
+	pb := &PersonBuilder{rng: b.rng, minAge: 18, maxAge: 60}
+	p := &Person{}
+	pb.generateGender(p)
+	pb.generateBirthday(p)
+	pb.generateLocation(p)
+	pb.generateIDNo(p)
+	return p.idNo
+}
+
+func (b *InvalidIDNoBuilder) generate(defect IDNoDefect) string {
+	idNo := b.validIDNo()
+
+	switch defect {
+	case IDDefectCheckCode:
+		expected := idNo[17:]
+		for {
+			code := b.rng.Choice(idCardCheckCodes)
+			if code != expected {
+				return idNo[:17] + code
+			}
+		}
+	case IDDefectBirthday:
+		return b.badBirthday(idNo)
+	case IDDefectAreaCode:
+		for {
+			area := fmt.Sprintf("%06d", b.rng.Intn(1000000))
+			if _, ok := metadata.AreaCodeMap[area]; !ok {
+				idNo17 := area + idNo[6:17]
+				return idNo17 + calculateCheckCode(idNo17)
+			}
+		}
+	case IDDefectLength:
+		if b.rng.Intn(2) == 0 {
+			return idNo[:17]
+		}
+		return idNo[:17] + fmt.Sprintf("%d", b.rng.Intn(10)) + idNo[17:]
+	case IDDefectLowercaseX:
+		return strings.ToLower(b.checkCodeX(idNo))
+	case IDDefectMisplacedX:
+		pos := b.rng.Intn(17)
+		return idNo[:pos] + "X" + idNo[pos+1:]
+	case IDDefectFullWidth:
+		var sb strings.Builder
+		for _, r := range idNo {
+			if r >= '0' && r <= '9' {
+				r += '０' - '0'
+			}
+			sb.WriteRune(r)
+		}
+		return sb.String()
+	case IDDefectWhitespace:
+		pos := b.rng.IntRange(1, 18)
+		ws := b.rng.Choice([]string{" ", "\t", "\u00a0", "\u3000"})
+		return idNo[:pos] + ws + idNo[pos:]
+	default:
+		return idNo
+	}
+}
+
+// badBirthday replaces the birthday with an impossible date and fixes the check code.
+func (b *InvalidIDNoBuilder) badBirthday(idNo string) string {
+	dates := []string{
+		"0230", "0231", "0431", "0631", "0931", "1131", // day past the end of the month
+		"1301", "0015", "0100", "0132", // month or day out of range
+	}
+	year := idNo[6:10]
+	date := b.rng.Choice(dates)
+	if b.rng.Intn(len(dates)+1) == 0 {
+		year = fmt.Sprintf("%d", b.rng.IntRange(1950, 2000)/4*4+1) // non-leap year
+		date = "0229"
+	}
+	idNo17 := idNo[:6] + year + date + idNo[14:17]
+	return idNo17 + calculateCheckCode(idNo17)
+}
+
+// checkCodeX changes the sequence code, keeping its parity, until the check code is X.
+func (b *InvalidIDNoBuilder) checkCodeX(idNo string) string {
+	start := b.rng.Intn(500)
+	parity := int(idNo[16]-'0') % 2
+	for i := 0; i < 500; i++ {
+		seq := (start+i)%500*2 + parity
+		idNo17 := fmt.Sprintf("%s%03d", idNo[:14], seq)
+		if code := calculateCheckCode(idNo17); code == "X" {
+			return idNo17 + code
+		}
+	}
+	return idNo
+}
+
+// BankNoDefect describes how a generated bank card number is invalid.
+type BankNoDefect int
+
+const (
+	BankDefectRandom     BankNoDefect = iota // any of the defects below
+	BankDefectLUHN                           // wrong LUHN check digit
+	BankDefectUnknownBIN                     // BIN not issued by any bank
+	BankDefectLength                         // valid LUHN, but the wrong length for the BIN
+	BankDefectNonDigit                       // a letter, space or dash in the number
+	BankDefectClosedBank                     // valid LUHN, BIN of a closed or merged bank
+)
+
+var bankNoDefects = []BankNoDefect{
+	BankDefectLUHN,
+	BankDefectUnknownBIN,
+	BankDefectLength,
+	BankDefectNonDigit,
+	BankDefectClosedBank,
+}
+
+// String returns the label of the defect.
+func (d BankNoDefect) String() string {
+	switch d {
+	case BankDefectLUHN:
+		return "luhn"
+	case BankDefectUnknownBIN:
+		return "unknown_bin"
+	case BankDefectLength:
+		return "length"
+	case BankDefectNonDigit:
+		return "non_digit"
+	case BankDefectClosedBank:
+		return "closed_bank"
+	default:
+		return "random"
+	}
+}
+
+// InvalidBankNo is a deliberately invalid bank card number and the defect it carries.
+type InvalidBankNo struct {
+	BankNo string
+	Defect BankNoDefect
+}
+
+// InvalidBankNoBuilder is a builder for deliberately invalid bank card numbers.
+type InvalidBankNoBuilder struct {
+	rng     *Rng
+	seed    int64
+	hasSeed bool
+	defects []BankNoDefect
+}
+
+// NewInvalidBankNo creates a new InvalidBankNoBuilder.
+func NewInvalidBankNo() *InvalidBankNoBuilder {
+	return &InvalidBankNoBuilder{}
+}
+
+// Defect restricts the generated defects to the given ones.
+// By default any defect may be generated.
+func (b *InvalidBankNoBuilder) Defect(defects ...BankNoDefect) *InvalidBankNoBuilder {
+	b.defects = defects
+	return b
+}
+
+// Seed sets the random seed for reproducibility.
+func (b *InvalidBankNoBuilder) Seed(seed int64) *InvalidBankNoBuilder {
+	b.seed = seed
+	b.hasSeed = true
+	return b
+}
+
+// Build generates a single invalid bank card number.
+func (b *InvalidBankNoBuilder) Build() InvalidBankNo {
+	if b.hasSeed {
+		b.rng = NewRngWithSeed(b.seed)
+	} else {
+		b.rng = NewRng()
+	}
+
+	defect := BankDefectRandom
+	if len(b.defects) > 0 {
+		defect = b.defects[b.rng.Intn(len(b.defects))]
+	}
+	if defect == BankDefectRandom {
+		defect = bankNoDefects[b.rng.Intn(len(bankNoDefects))]
+	}
+
+	return InvalidBankNo{BankNo: b.generate(defect), Defect: defect}
+}
+
+// BuildN generates multiple invalid bank card numbers.
+func (b *InvalidBankNoBuilder) BuildN(n int) []InvalidBankNo {
+	cards := make([]InvalidBankNo, n)
+	for i := 0; i < n; i++ {
+		builder := *b
+		if b.hasSeed {
+			builder.seed = b.seed + int64(i)
+		}
+		cards[i] = builder.Build()
+	}
+	return cards
+}
+
+// randomCardBin picks a bank and one of its prefixes from bins.
+func (b *InvalidBankNoBuilder) randomCardBin(bins []metadata.CardBin) (metadata.CardBin, string) {
+	bank := bins[b.rng.Intn(len(bins))]
+	prefix := bank.Prefixes[b.rng.Intn(len(bank.Prefixes))]
+	return bank, strconv.Itoa(prefix)
+}
+
+func (b *InvalidBankNoBuilder) generate(defect BankNoDefect) string {
+	switch defect {
+	case BankDefectLUHN:
+		bank, prefix := b.randomCardBin(metadata.CardBins)
+		cardNo := bankCardNo(b.rng, prefix, bank.Length)
+		last := len(cardNo) - 1
+		wrong := (int(cardNo[last]-'0') + b.rng.IntRange(1, 10)) % 10
+		return cardNo[:last] + strconv.Itoa(wrong)
+	case BankDefectUnknownBIN:
+		for {
+			bin := strconv.Itoa(b.rng.IntRange(100000, 1000000))
+			if !isKnownBIN(bin) {
+				length := 16
+				if b.rng.Intn(2) == 0 {
+					length = 19
+				}
+				return bankCardNo(b.rng, bin, length)
+			}
+		}
+	case BankDefectLength:
+		bank, prefix := b.randomCardBin(metadata.CardBins)
+		length := bank.Length
+		for length == bank.Length {
+			length = b.rng.IntRange(13, 20)
+		}
+		return bankCardNo(b.rng, prefix, length)
+	case BankDefectNonDigit:
+		bank, prefix := b.randomCardBin(metadata.CardBins)
+		cardNo := bankCardNo(b.rng, prefix, bank.Length)
+		pos := b.rng.IntRange(len(prefix), len(cardNo))
+		return cardNo[:pos] + b.rng.Choice([]string{"A", "O", "l", " ", "-"}) + cardNo[pos+1:]
+	case BankDefectClosedBank:
+		bank, prefix := b.randomCardBin(metadata.ClosedCardBins)
+		return bankCardNo(b.rng, prefix, bank.Length)
+	default:
+		bank, prefix := b.randomCardBin(metadata.CardBins)
+		return bankCardNo(b.rng, prefix, bank.Length)
+	}
+}
@@ -0,0 +1,107 @@
+package chinaid
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+func TestInvalidIDNo(t *testing.T) {
+	for _, defect := range idNoDefects {
+		for _, inv := range NewInvalidIDNo().Defect(defect).BuildN(50) {
+			if inv.Defect != defect {
+				t.Fatalf("Defect = %v, want %v", inv.Defect, defect)
+			}
+			err := CheckIDNo(inv.IDNo, Strict(), UppercaseX())
+			if !errors.Is(err, defect.Err()) {
+				t.Errorf("%s: CheckIDNo(%q, Strict(), UppercaseX()) = %v, want %v", defect, inv.IDNo, err, defect.Err())
+			}
+		}
+	}
+}
+
+func TestInvalidIDNoAreaCodeInBirthYear(t *testing.T) {
+	// Apart from the labelled defect, the area code must be one in use in the
+	// birth year, not a code retired before it.
+	for _, inv := range NewInvalidIDNo().Seed(1).Defect(IDDefectCheckCode).BuildN(5000) {
+		year, _ := strconv.Atoi(inv.IDNo[6:10])
+		if h, ok := metadata.HistoricalAreaCodeMap[inv.IDNo[:6]]; ok && !h.ValidIn(year) {
+			t.Fatalf("%s carries %s (%s), not in use in %d", inv.IDNo, h.Code, h.Name, year)
+		}
+	}
+}
+
+func TestInvalidIDNoRandomDefect(t *testing.T) {
+	seen := make(map[IDNoDefect]bool)
+	for _, inv := range NewInvalidIDNo().BuildN(200) {
+		if inv.Defect == IDDefectRandom {
+			t.Fatal("Defect should be resolved to a concrete defect")
+		}
+		if ValidateIDNo(inv.IDNo, Strict(), UppercaseX()) {
+			t.Errorf("%s: %q should be invalid", inv.Defect, inv.IDNo)
+		}
+		seen[inv.Defect] = true
+	}
+	if len(seen) < 4 {
+		t.Errorf("Random defects should vary, got %v", seen)
+	}
+}
+
+func TestInvalidIDNoSeed(t *testing.T) {
+	a := NewInvalidIDNo().Seed(42).Build()
+	b := NewInvalidIDNo().Seed(42).Build()
+	if a != b {
+		t.Errorf("Same seed should produce same result: %v vs %v", a, b)
+	}
+}
+
+func TestInvalidBankNo(t *testing.T) {
+	for _, defect := range bankNoDefects {
+		for _, inv := range NewInvalidBankNo().Defect(defect).BuildN(50) {
+			if inv.Defect != defect {
+				t.Fatalf("Defect = %v, want %v", inv.Defect, defect)
+			}
+			err := CheckBankNo(inv.BankNo)
+			switch defect {
+			case BankDefectLUHN:
+				if !errors.Is(err, ErrBankNoChecksum) {
+					t.Errorf("%s: CheckBankNo(%q) = %v, want ErrBankNoChecksum", defect, inv.BankNo, err)
+				}
+			case BankDefectNonDigit:
+				if !errors.Is(err, ErrBankNoFormat) {
+					t.Errorf("%s: CheckBankNo(%q) = %v, want ErrBankNoFormat", defect, inv.BankNo, err)
+				}
+			default:
+				if err != nil {
+					t.Errorf("%s: CheckBankNo(%q) = %v, want nil", defect, inv.BankNo, err)
+				}
+			}
+
+			info, err := LookupBankCard(inv.BankNo)
+			switch defect {
+			case BankDefectUnknownBIN:
+				if !errors.Is(err, ErrBankNoUnknownBIN) {
+					t.Errorf("%s: LookupBankCard(%q) = %v, want ErrBankNoUnknownBIN", defect, inv.BankNo, err)
+				}
+			case BankDefectClosedBank:
+				if err != nil || !info.Closed {
+					t.Errorf("%s: LookupBankCard(%q) = %+v, %v, want closed bank", defect, inv.BankNo, info, err)
+				}
+			case BankDefectLength:
+				if err != nil || info.LengthValid {
+					t.Errorf("%s: LookupBankCard(%q) = %+v, %v, want invalid length", defect, inv.BankNo, info, err)
+				}
+			}
+		}
+	}
+}
+
+func TestInvalidBankNoSeed(t *testing.T) {
+	a := NewInvalidBankNo().Seed(42).Build()
+	b := NewInvalidBankNo().Seed(42).Build()
+	if a != b {
+		t.Errorf("Same seed should produce same result: %v vs %v", a, b)
+	}
+}
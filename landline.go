@@ -0,0 +1,174 @@
+package chinaid
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// Landline is a fixed-line phone number.
+type Landline struct {
+	AreaCode  string // long-distance area code (长途区号) with the leading 0, e.g. "0571"
+	Number    string // local number of 7 or 8 digits, e.g. "86123456"
+	Extension string // extension (分机号), e.g. "802", empty if none
+}
+
+// String formats the number with a dash and without the extension, e.g.
+// "0571-86123456".
+func (l Landline) String() string {
+	return l.AreaCode + "-" + l.Number
+}
+
+// International formats the number with the country code and without the
+// leading 0 of the area code, e.g. "+86-571-86123456".
+func (l Landline) International() string {
+	return "+86-" + strings.TrimPrefix(l.AreaCode, "0") + "-" + l.Number
+}
+
+// WithExtension formats the number followed by the extension, e.g.
+// "0571-86123456-802", or like String if there is no extension.
+func (l Landline) WithExtension() string {
+	if l.Extension == "" {
+		return l.String()
+	}
+	return l.String() + "-" + l.Extension
+}
+
+// Cities returns the cities using the area code, in code order.
+func (l Landline) Cities() []*Region {
+	return append([]*Region(nil), phoneCodeCities[l.AreaCode]...)
+}
+
+// phoneCodeDigits maps the area codes of metadata.Provinces to the length of
+// their local numbers; phoneCodeCities maps them to the cities using them.
+var phoneCodeDigits, phoneCodeCities = func() (map[string]int, map[string][]*Region) {
+	digits := make(map[string]int)
+	cities := make(map[string][]*Region)
+	for _, p := range metadata.Provinces {
+		for _, c := range p.Cities {
+			digits[c.PhoneCode] = c.PhoneDigits
+			cities[c.PhoneCode] = append(cities[c.PhoneCode], regionCodes[c.Code])
+		}
+	}
+	return digits, cities
+}()
+
+// lookupPhoneCode returns the area code number starts with, or "" if none.
+func lookupPhoneCode(number string) string {
+	for _, l := range []int{3, 4} {
+		if len(number) >= l {
+			if _, ok := phoneCodeDigits[number[:l]]; ok {
+				return number[:l]
+			}
+		}
+	}
+	return ""
+}
+
+func landlineError(err error, pos int) *ValidationError {
+	return &ValidationError{Field: FieldLandline, Position: pos, Err: err}
+}
+
+// ParseLandline parses a fixed-line number written as "0571-86123456",
+// "057186123456" or "+86-571-86123456", optionally followed by a dash and an
+// extension, e.g. "0571-86123456-802". Spaces are ignored and "0086" may be
+// used for "+86".
+// It returns a *ValidationError wrapping ErrLandlineFormat, ErrLandlineAreaCode
+// or ErrLandlineNumber if the number is malformed, its area code is not in
+// metadata.Provinces, or its local number starts with 0 or 1 or does not have
+// the length used with the area code.
+func ParseLandline(number string) (*Landline, error) {
+	for i := 0; i < len(number); i++ {
+		c := number[i]
+		if !isDigit(c) && c != '-' && c != ' ' && (c != '+' || i > 0) {
+			return nil, landlineError(ErrLandlineFormat, i)
+		}
+	}
+
+	s := strings.ReplaceAll(number, " ", "")
+	for _, prefix := range []string{"+86", "0086"} {
+		if rest, ok := strings.CutPrefix(s, prefix); ok {
+			s = "0" + strings.TrimPrefix(rest, "-")
+			break
+		}
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) > 3 || slices.Contains(parts, "") || strings.HasPrefix(s, "+") {
+		return nil, landlineError(ErrLandlineFormat, -1)
+	}
+
+	code := lookupPhoneCode(parts[0])
+	if code == "" {
+		return nil, landlineError(ErrLandlineAreaCode, 0)
+	}
+	l := &Landline{AreaCode: code}
+	if len(parts[0]) == len(code) {
+		if len(parts) < 2 {
+			return nil, landlineError(ErrLandlineNumber, -1)
+		}
+		l.Number = parts[1]
+		if len(parts) == 3 {
+			l.Extension = parts[2]
+		}
+	} else {
+		if len(parts) == 3 {
+			return nil, landlineError(ErrLandlineFormat, -1)
+		}
+		l.Number = parts[0][len(code):]
+		if len(parts) == 2 {
+			l.Extension = parts[1]
+		}
+	}
+	if len(l.Number) != phoneCodeDigits[code] || l.Number[0] == '0' || l.Number[0] == '1' {
+		return nil, landlineError(ErrLandlineNumber, -1)
+	}
+	return l, nil
+}
+
+// CheckLandline checks that number is a fixed-line number of city, given by
+// name or code as accepted by FindRegions and LookupRegion, e.g. "杭州",
+// "杭州市", "西湖区" or "330100". A province accepts the area codes of all its
+// cities.
+// It returns the errors of ParseLandline, an error wrapping ErrRegionNotFound
+// if city is unknown, or a *ValidationError wrapping ErrLandlineCity if the
+// area code is not used in city.
+func CheckLandline(number, city string) error {
+	l, err := ParseLandline(number)
+	if err != nil {
+		return err
+	}
+
+	var regions []*Region
+	if city != "" && strings.Trim(city, "0123456789") == "" {
+		r, err := LookupRegion(city)
+		if err != nil {
+			return err
+		}
+		regions = append(regions, r)
+	} else if regions = FindRegions(city); len(regions) == 0 {
+		return fmt.Errorf("%w: %q", ErrRegionNotFound, city)
+	}
+
+	for _, r := range regions {
+		if r.level != RegionProvince {
+			if r.phoneCode == l.AreaCode {
+				return nil
+			}
+			continue
+		}
+		for _, c := range r.children {
+			if c.phoneCode == l.AreaCode {
+				return nil
+			}
+		}
+	}
+	return landlineError(ErrLandlineCity, 0)
+}
+
+// ValidateLandline reports whether number is a fixed-line number of city; see
+// CheckLandline.
+func ValidateLandline(number, city string) bool {
+	return CheckLandline(number, city) == nil
+}
@@ -0,0 +1,156 @@
+package chinaid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+func TestLandlineFormats(t *testing.T) {
+	l := Landline{AreaCode: "0571", Number: "86123456", Extension: "802"}
+	if got := l.String(); got != "0571-86123456" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := l.International(); got != "+86-571-86123456" {
+		t.Errorf("International() = %q", got)
+	}
+	if got := l.WithExtension(); got != "0571-86123456-802" {
+		t.Errorf("WithExtension() = %q", got)
+	}
+	l.Extension = ""
+	if got := l.WithExtension(); got != "0571-86123456" {
+		t.Errorf("WithExtension() without extension = %q", got)
+	}
+}
+
+func TestParseLandline(t *testing.T) {
+	tests := []struct {
+		number string
+		want   Landline
+	}{
+		{"0571-86123456", Landline{"0571", "86123456", ""}},
+		{"057186123456", Landline{"0571", "86123456", ""}},
+		{"0571 8612 3456", Landline{"0571", "86123456", ""}},
+		{"+86-571-86123456", Landline{"0571", "86123456", ""}},
+		{"+86 571 86123456", Landline{"0571", "86123456", ""}},
+		{"0086-10-65123456", Landline{"010", "65123456", ""}},
+		{"0571-86123456-802", Landline{"0571", "86123456", "802"}},
+		{"057186123456-802", Landline{"0571", "86123456", "802"}},
+		{"010-65123456", Landline{"010", "65123456", ""}},
+		{"0898-66123456", Landline{"0898", "66123456", ""}},
+		{"0570-3123456", Landline{"0570", "3123456", ""}},
+	}
+	for _, tt := range tests {
+		l, err := ParseLandline(tt.number)
+		if err != nil {
+			t.Errorf("ParseLandline(%q) returned error: %v", tt.number, err)
+			continue
+		}
+		if *l != tt.want {
+			t.Errorf("ParseLandline(%q) = %+v, want %+v", tt.number, *l, tt.want)
+		}
+	}
+}
+
+func TestParseLandlineErrors(t *testing.T) {
+	tests := []struct {
+		number string
+		want   error
+		pos    int
+	}{
+		{"0571-8612345a", ErrLandlineFormat, 12},
+		{"0571+86123456", ErrLandlineFormat, 4},
+		{"0571--86123456", ErrLandlineFormat, -1},
+		{"0571-8612-3456-802", ErrLandlineFormat, -1},
+		{"+1-571-86123456", ErrLandlineFormat, -1},
+		{"", ErrLandlineFormat, -1},
+		{"0001-86123456", ErrLandlineAreaCode, 0},
+		{"+86-0571-86123456", ErrLandlineAreaCode, 0},
+		{"0571", ErrLandlineNumber, -1},
+		{"0571-8612345", ErrLandlineNumber, -1},
+		{"0570-86123456", ErrLandlineNumber, -1},
+		{"0571-06123456", ErrLandlineNumber, -1},
+	}
+	for _, tt := range tests {
+		_, err := ParseLandline(tt.number)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("ParseLandline(%q) error = %v, want %v", tt.number, err, tt.want)
+			continue
+		}
+		var ve *ValidationError
+		if !errors.As(err, &ve) || ve.Field != FieldLandline || ve.Position != tt.pos {
+			t.Errorf("ParseLandline(%q) error = %#v, want field %s position %d", tt.number, err, FieldLandline, tt.pos)
+		}
+	}
+}
+
+func TestCheckLandline(t *testing.T) {
+	tests := []struct {
+		number string
+		city   string
+		want   error
+	}{
+		{"0571-86123456", "杭州", nil},
+		{"0571-86123456", "杭州市", nil},
+		{"0571-86123456", "西湖区", nil},
+		{"0571-86123456", "330100", nil},
+		{"0571-86123456", "浙江", nil},
+		{"010-65123456", "北京", nil},
+		{"010-65123456", "朝阳区", nil},
+		{"0731-85123456", "湘潭市", nil},
+		{"0898-66123456", "三亚", nil},
+		{"0571-86123456", "宁波", ErrLandlineCity},
+		{"0571-86123456", "江苏", ErrLandlineCity},
+		{"0571-86123456", "不存在市", ErrRegionNotFound},
+		{"0571-86123456", "999999", ErrRegionNotFound},
+		{"0571-8612345", "杭州", ErrLandlineNumber},
+	}
+	for _, tt := range tests {
+		err := CheckLandline(tt.number, tt.city)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("CheckLandline(%q, %q) = %v, want %v", tt.number, tt.city, err, tt.want)
+		}
+		if ValidateLandline(tt.number, tt.city) != (tt.want == nil) {
+			t.Errorf("ValidateLandline(%q, %q) = %t", tt.number, tt.city, tt.want != nil)
+		}
+	}
+}
+
+func TestLandlineCities(t *testing.T) {
+	l := Landline{AreaCode: "0731"}
+	var names []string
+	for _, c := range l.Cities() {
+		names = append(names, c.Name())
+	}
+	if got := fmt.Sprint(names); got != "[长沙市 株洲市 湘潭市]" {
+		t.Errorf("Cities() of 0731 = %s", got)
+	}
+}
+
+func TestPhoneCodes(t *testing.T) {
+	digits := make(map[string]int)
+	for _, p := range metadata.Provinces {
+		for _, c := range p.Cities {
+			if len(c.PhoneCode) < 3 || len(c.PhoneCode) > 4 || c.PhoneCode[0] != '0' {
+				t.Errorf("%s: invalid phone code %q", c.Name, c.PhoneCode)
+			}
+			if c.PhoneDigits != 7 && c.PhoneDigits != 8 {
+				t.Errorf("%s: invalid phone digits %d", c.Name, c.PhoneDigits)
+			}
+			if d, ok := digits[c.PhoneCode]; ok && d != c.PhoneDigits {
+				t.Errorf("%s: phone code %s has %d digits, another city has %d", c.Name, c.PhoneCode, c.PhoneDigits, d)
+			}
+			digits[c.PhoneCode] = c.PhoneDigits
+		}
+	}
+	// A 3-digit code must not be the prefix of a 4-digit one.
+	for code := range digits {
+		if len(code) == 4 {
+			if _, ok := digits[code[:3]]; ok {
+				t.Errorf("phone code %s starts with phone code %s", code, code[:3])
+			}
+		}
+	}
+}
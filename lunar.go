@@ -0,0 +1,195 @@
+package chinaid
+
+import (
+	"fmt"
+	"time"
+)
+
+// lunarInfo encodes the lunar years 1900-2100, one entry per year:
+// bits 0-3 hold the leap month (0 if none), bits 4-15 the sizes of months
+// 12 down to 1 (1 means 30 days, 0 means 29 days) and bit 16 the size of
+// the leap month.
+var lunarInfo = []int{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2,
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977,
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970,
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950,
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557,
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0,
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0,
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6,
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570,
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0,
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5,
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930,
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530,
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45,
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0,
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06aa0, 0x1a6c4, 0x0aae0,
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4,
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0,
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160,
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252,
+	0x0d520,
+}
+
+const (
+	lunarMinYear = 1900
+	lunarMaxYear = 2100
+)
+
+// lunarBase is the first day of lunar year 1900 (正月初一).
+var lunarBase = time.Date(1900, 1, 31, 0, 0, 0, 0, time.UTC)
+
+var (
+	heavenlyStems   = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	lunarMonthNames = []string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+	lunarDayTens    = []string{"初", "十", "廿", "三"}
+	lunarDayUnits   = []string{"十", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+)
+
+// LunarDate is a date in the Chinese lunar calendar (农历).
+type LunarDate struct {
+	Year   int  // lunar year, 1900-2100
+	Month  int  // lunar month, 1-12
+	Day    int  // lunar day, 1-30
+	IsLeap bool // the month is a leap month (闰月)
+}
+
+// String returns the Chinese rendering, e.g. "庚午年腊月初八" or "甲申年闰二月十五".
+func (d LunarDate) String() string {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return ""
+	}
+	leap := ""
+	if d.IsLeap {
+		leap = "闰"
+	}
+	return fmt.Sprintf("%s年%s%s月%s", d.GanZhi(), leap, lunarMonthNames[d.Month-1], lunarDayName(d.Day))
+}
+
+// GanZhi returns the sexagenary name of the lunar year, e.g. "庚午".
+func (d LunarDate) GanZhi() string {
+	n := ((d.Year-4)%60 + 60) % 60
+	return heavenlyStems[n%10] + earthlyBranches[n%12]
+}
+
+// Zodiac returns the Chinese zodiac animal of the lunar year.
+func (d LunarDate) Zodiac() Zodiac {
+	return zodiacOfYear(d.Year)
+}
+
+func lunarDayName(day int) string {
+	switch day {
+	case 10:
+		return "初十"
+	case 20:
+		return "二十"
+	case 30:
+		return "三十"
+	}
+	return lunarDayTens[day/10] + lunarDayUnits[day%10]
+}
+
+// lunarLeapMonth returns the leap month of the year, 0 if there is none.
+func lunarLeapMonth(year int) int {
+	return lunarInfo[year-lunarMinYear] & 0xf
+}
+
+// lunarMonthDays returns the number of days of the month, or of its leap month if leap is set.
+func lunarMonthDays(year, month int, leap bool) int {
+	bit := 0x10000 >> month
+	if leap {
+		bit = 0x10000
+	}
+	if lunarInfo[year-lunarMinYear]&bit != 0 {
+		return 30
+	}
+	return 29
+}
+
+// lunarYearDays returns the number of days of the lunar year.
+func lunarYearDays(year int) int {
+	days := 0
+	for m := 1; m <= 12; m++ {
+		days += lunarMonthDays(year, m, false)
+	}
+	if lunarLeapMonth(year) != 0 {
+		days += lunarMonthDays(year, lunarLeapMonth(year), true)
+	}
+	return days
+}
+
+// SolarToLunar converts the calendar date of t to a lunar date.
+// Dates from 1900-01-31 (lunar 1900-01-01) to 2101-01-28 (the last day of
+// lunar year 2100) are supported.
+func SolarToLunar(t time.Time) (LunarDate, error) {
+	offset := int(dateOf(t).Sub(lunarBase).Hours() / 24)
+	if offset < 0 {
+		return LunarDate{}, ErrLunarRange
+	}
+
+	year := lunarMinYear
+	for ; year <= lunarMaxYear; year++ {
+		days := lunarYearDays(year)
+		if offset < days {
+			break
+		}
+		offset -= days
+	}
+	if year > lunarMaxYear {
+		return LunarDate{}, ErrLunarRange
+	}
+
+	leapMonth := lunarLeapMonth(year)
+	for month := 1; month <= 12; month++ {
+		days := lunarMonthDays(year, month, false)
+		if offset < days {
+			return LunarDate{Year: year, Month: month, Day: offset + 1}, nil
+		}
+		offset -= days
+		if month == leapMonth {
+			days = lunarMonthDays(year, month, true)
+			if offset < days {
+				return LunarDate{Year: year, Month: month, Day: offset + 1, IsLeap: true}, nil
+			}
+			offset -= days
+		}
+	}
+	return LunarDate{}, ErrLunarRange
+}
+
+// LunarToSolar converts a lunar date to the Gregorian date at midnight
+// China Standard Time. It returns ErrLunarRange for years outside 1900-2100
+// and ErrLunarDate for dates that do not exist, such as a leap month in a
+// year without one or the 30th day of a 29-day month.
+func LunarToSolar(d LunarDate) (time.Time, error) {
+	if d.Year < lunarMinYear || d.Year > lunarMaxYear {
+		return time.Time{}, ErrLunarRange
+	}
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 ||
+		(d.IsLeap && lunarLeapMonth(d.Year) != d.Month) ||
+		d.Day > lunarMonthDays(d.Year, d.Month, d.IsLeap) {
+		return time.Time{}, ErrLunarDate
+	}
+
+	offset := 0
+	for y := lunarMinYear; y < d.Year; y++ {
+		offset += lunarYearDays(y)
+	}
+	leapMonth := lunarLeapMonth(d.Year)
+	for m := 1; m < d.Month; m++ {
+		offset += lunarMonthDays(d.Year, m, false)
+		if m == leapMonth {
+			offset += lunarMonthDays(d.Year, m, true)
+		}
+	}
+	if d.IsLeap {
+		offset += lunarMonthDays(d.Year, d.Month, false)
+	}
+	offset += d.Day - 1
+
+	solar := lunarBase.AddDate(0, 0, offset)
+	return time.Date(solar.Year(), solar.Month(), solar.Day(), 0, 0, 0, 0, chinaLocation), nil
+}
@@ -0,0 +1,108 @@
+package chinaid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSolarToLunar(t *testing.T) {
+	tests := []struct {
+		solar string
+		want  LunarDate
+		str   string
+	}{
+		{"1900-01-31", LunarDate{1900, 1, 1, false}, "庚子年正月初一"},
+		{"1991-01-23", LunarDate{1990, 12, 8, false}, "庚午年腊月初八"},
+		{"2004-04-15", LunarDate{2004, 2, 26, true}, "甲申年闰二月廿六"},
+		{"2020-05-23", LunarDate{2020, 4, 1, true}, "庚子年闰四月初一"},
+		{"2023-04-20", LunarDate{2023, 3, 1, false}, "癸卯年三月初一"},
+		{"2101-01-28", LunarDate{2100, 12, 29, false}, "庚申年腊月廿九"},
+	}
+	for _, tt := range tests {
+		solar, _ := time.ParseInLocation(time.DateOnly, tt.solar, chinaLocation)
+		got, err := SolarToLunar(solar)
+		if err != nil {
+			t.Errorf("SolarToLunar(%s) error: %v", tt.solar, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("SolarToLunar(%s) = %+v, want %+v", tt.solar, got, tt.want)
+		}
+		if got.String() != tt.str {
+			t.Errorf("SolarToLunar(%s).String() = %s, want %s", tt.solar, got.String(), tt.str)
+		}
+		back, err := LunarToSolar(tt.want)
+		if err != nil || !back.Equal(solar) {
+			t.Errorf("LunarToSolar(%+v) = %v, %v, want %s", tt.want, back, err, tt.solar)
+		}
+	}
+}
+
+func TestSolarToLunarRoundTrip(t *testing.T) {
+	for d := lunarBase; d.Year() <= lunarMaxYear; d = d.AddDate(0, 0, 1) {
+		l, err := SolarToLunar(d)
+		if err != nil {
+			t.Fatalf("SolarToLunar(%s) error: %v", d.Format(time.DateOnly), err)
+		}
+		back, err := LunarToSolar(l)
+		if err != nil || back.Format(time.DateOnly) != d.Format(time.DateOnly) {
+			t.Fatalf("LunarToSolar(%+v) = %v, %v, want %s", l, back, err, d.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestLunarErrors(t *testing.T) {
+	if _, err := SolarToLunar(time.Date(1900, 1, 30, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrLunarRange) {
+		t.Errorf("SolarToLunar(1900-01-30) error = %v, want ErrLunarRange", err)
+	}
+	if _, err := SolarToLunar(time.Date(2101, 1, 29, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrLunarRange) {
+		t.Errorf("SolarToLunar(2101-01-29) error = %v, want ErrLunarRange", err)
+	}
+
+	tests := []struct {
+		date LunarDate
+		want error
+	}{
+		{LunarDate{1899, 1, 1, false}, ErrLunarRange},
+		{LunarDate{2101, 1, 1, false}, ErrLunarRange},
+		{LunarDate{2000, 13, 1, false}, ErrLunarDate},
+		{LunarDate{2000, 1, 0, false}, ErrLunarDate},
+		{LunarDate{2004, 3, 1, true}, ErrLunarDate},    // 2004 leaps the 2nd month
+		{LunarDate{2100, 12, 30, false}, ErrLunarDate}, // 29-day month
+	}
+	for _, tt := range tests {
+		if _, err := LunarToSolar(tt.date); !errors.Is(err, tt.want) {
+			t.Errorf("LunarToSolar(%+v) error = %v, want %v", tt.date, err, tt.want)
+		}
+	}
+}
+
+func TestPersonLunarBirthday(t *testing.T) {
+	date := LunarDate{Year: 2020, Month: 4, Day: 1, IsLeap: true}
+	p := NewPerson().LunarBirthday(date).Seed(1).Build()
+	if got := p.Birthday().Format(time.DateOnly); got != "2020-05-23" {
+		t.Errorf("Birthday = %s, want 2020-05-23", got)
+	}
+	if p.LunarBirthday() != date {
+		t.Errorf("LunarBirthday = %+v, want %+v", p.LunarBirthday(), date)
+	}
+	if p.IDNo()[6:14] != "20200523" {
+		t.Errorf("IDNo %s does not carry the solar birthday", p.IDNo())
+	}
+
+	_, err := NewPerson().LunarBirthday(LunarDate{Year: 2021, Month: 4, Day: 1, IsLeap: true}).TryBuild()
+	if !errors.Is(err, ErrUnsatisfiable) || !errors.Is(err, ErrLunarDate) {
+		t.Errorf("TryBuild error = %v, want ErrUnsatisfiable and ErrLunarDate", err)
+	}
+
+	// A later valid birthday replaces the invalid one.
+	b := NewPerson().LunarBirthday(LunarDate{Year: 2021, Month: 4, Day: 1, IsLeap: true})
+	if _, err := b.LunarBirthday(date).TryBuild(); err != nil {
+		t.Errorf("TryBuild after a valid LunarBirthday error = %v", err)
+	}
+	b = NewPerson().LunarBirthday(LunarDate{Year: 1800, Month: 1, Day: 1})
+	if _, err := b.Birthday(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)).TryBuild(); err != nil {
+		t.Errorf("TryBuild after a valid Birthday error = %v", err)
+	}
+}
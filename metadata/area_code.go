@@ -1,392 +1,4939 @@
 package metadata
 
-// Province 省份信息
+// Province 省级行政区
 type Province struct {
 	Name   string // 省份全称："北京市"
 	Short  string // 简称："北京"
+	Abbr   string // 单字简称："京"
 	Code   string // 省级代码："11"
-	Cities []City // 下属城市/区县
+	Cities []City // 下属地级行政区
+
+	Population int // 常住人口（万人），第七次全国人口普查（2020）
 }
 
-// City 城市/区县信息
+// City 地级行政区（地级市、地区、自治州、盟）
+// 直辖市整体作为一个 City；不设区的地级市与省直辖县级行政区也各自作为一个 City，
+// 其下只有一个与自身同名、同代码的 District
 type City struct {
-	Name      string   // 名称："朝阳区"
-	AreaCodes []string // 6位地区码
+	Name      string     // 名称："杭州市"
+	Code      string     // 6位代码："330100"
+	Districts []District // 下属县级行政区
+
+	Population  int    // 常住人口（万人），第七次全国人口普查（2020）
+	PhoneCode   string // 固定电话长途区号："0571"；相邻城市可能共用一个区号
+	PhoneDigits int    // 固定电话本地号码位数：7 或 8
+}
+
+// District 县级行政区（市辖区、县级市、县、旗等）
+type District struct {
+	Name       string // 名称："西湖区"
+	Code       string // 6位地区码："330106"
+	PostalCode string // 邮政编码："310013"；同一城市的市辖区可能共用一个邮政编码
+	Since      int    // 启用年份，0 表示 1980 年以前即已使用
 }
 
-// Provinces 全国省份数据
+// Provinces 全国省级、地级、县级行政区划数据（GB/T 2260）
 var Provinces = []Province{
 	{
-		Name: "北京市", Short: "北京", Code: "11",
+		Name: "北京市", Short: "北京", Abbr: "京", Code: "11", Population: 2189,
 		Cities: []City{
-			{Name: "东城区", AreaCodes: []string{"110101"}},
-			{Name: "西城区", AreaCodes: []string{"110102"}},
-			{Name: "朝阳区", AreaCodes: []string{"110105"}},
-			{Name: "丰台区", AreaCodes: []string{"110106"}},
-			{Name: "石景山区", AreaCodes: []string{"110107"}},
-			{Name: "海淀区", AreaCodes: []string{"110108"}},
-			{Name: "顺义区", AreaCodes: []string{"110113"}},
-			{Name: "通州区", AreaCodes: []string{"110112"}},
-			{Name: "大兴区", AreaCodes: []string{"110115"}},
-			{Name: "昌平区", AreaCodes: []string{"110114"}},
+			{
+				Name: "北京市", Code: "110100", Population: 2189, PhoneCode: "010", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "东城区", Code: "110101", PostalCode: "100010"},
+					{Name: "西城区", Code: "110102", PostalCode: "100032"},
+					{Name: "朝阳区", Code: "110105", PostalCode: "100020"},
+					{Name: "丰台区", Code: "110106", PostalCode: "100071"},
+					{Name: "石景山区", Code: "110107", PostalCode: "100043"},
+					{Name: "海淀区", Code: "110108", PostalCode: "100089"},
+					{Name: "门头沟区", Code: "110109", PostalCode: "102300"},
+					{Name: "房山区", Code: "110111", PostalCode: "102488", Since: 1986},
+					{Name: "通州区", Code: "110112", PostalCode: "101100", Since: 1997},
+					{Name: "顺义区", Code: "110113", PostalCode: "101300", Since: 1998},
+					{Name: "昌平区", Code: "110114", PostalCode: "102200", Since: 1999},
+					{Name: "大兴区", Code: "110115", PostalCode: "102600", Since: 2001},
+					{Name: "怀柔区", Code: "110116", PostalCode: "101400", Since: 2001},
+					{Name: "平谷区", Code: "110117", PostalCode: "101200", Since: 2001},
+					{Name: "密云区", Code: "110118", PostalCode: "101500", Since: 2015},
+					{Name: "延庆区", Code: "110119", PostalCode: "102100", Since: 2015},
+				},
+			},
 		},
 	},
 	{
-		Name: "天津市", Short: "天津", Code: "12",
+		Name: "天津市", Short: "天津", Abbr: "津", Code: "12", Population: 1387,
 		Cities: []City{
-			{Name: "和平区", AreaCodes: []string{"120101"}},
-			{Name: "河东区", AreaCodes: []string{"120102"}},
-			{Name: "河西区", AreaCodes: []string{"120103"}},
-			{Name: "南开区", AreaCodes: []string{"120104"}},
-			{Name: "河北区", AreaCodes: []string{"120105"}},
-			{Name: "滨海新区", AreaCodes: []string{"120116"}},
-			{Name: "东丽区", AreaCodes: []string{"120110"}},
-			{Name: "西青区", AreaCodes: []string{"120111"}},
+			{
+				Name: "天津市", Code: "120100", Population: 1387, PhoneCode: "022", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "和平区", Code: "120101", PostalCode: "300041"},
+					{Name: "河东区", Code: "120102", PostalCode: "300171"},
+					{Name: "河西区", Code: "120103", PostalCode: "300202"},
+					{Name: "南开区", Code: "120104", PostalCode: "300100"},
+					{Name: "河北区", Code: "120105", PostalCode: "300143"},
+					{Name: "红桥区", Code: "120106", PostalCode: "300131"},
+					{Name: "东丽区", Code: "120110", PostalCode: "300300"},
+					{Name: "西青区", Code: "120111", PostalCode: "300380"},
+					{Name: "津南区", Code: "120112", PostalCode: "300350"},
+					{Name: "北辰区", Code: "120113", PostalCode: "300400"},
+					{Name: "武清区", Code: "120114", PostalCode: "301700", Since: 2000},
+					{Name: "宝坻区", Code: "120115", PostalCode: "301800", Since: 2001},
+					{Name: "滨海新区", Code: "120116", PostalCode: "300450", Since: 2009},
+					{Name: "宁河区", Code: "120117", PostalCode: "301500", Since: 2015},
+					{Name: "静海区", Code: "120118", PostalCode: "301600", Since: 2015},
+					{Name: "蓟州区", Code: "120119", PostalCode: "301900", Since: 2016},
+				},
+			},
 		},
 	},
 	{
-		Name: "河北省", Short: "河北", Code: "13",
+		Name: "河北省", Short: "河北", Abbr: "冀", Code: "13", Population: 7461,
 		Cities: []City{
-			{Name: "石家庄市", AreaCodes: []string{"130100", "130102"}},
-			{Name: "唐山市", AreaCodes: []string{"130200", "130202"}},
-			{Name: "秦皇岛市", AreaCodes: []string{"130300"}},
-			{Name: "邯郸市", AreaCodes: []string{"130400"}},
-			{Name: "保定市", AreaCodes: []string{"130600"}},
-			{Name: "张家口市", AreaCodes: []string{"130700"}},
-			{Name: "廊坊市", AreaCodes: []string{"131000"}},
+			{
+				Name: "石家庄市", Code: "130100", Population: 1124, PhoneCode: "0311", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "长安区", Code: "130102", PostalCode: "050000"},
+					{Name: "桥西区", Code: "130104", PostalCode: "050000"},
+					{Name: "新华区", Code: "130105", PostalCode: "050000"},
+					{Name: "井陉矿区", Code: "130107", PostalCode: "050100"},
+					{Name: "裕华区", Code: "130108", PostalCode: "050000"},
+					{Name: "藁城区", Code: "130109", PostalCode: "052160", Since: 2014},
+					{Name: "鹿泉区", Code: "130110", PostalCode: "050200", Since: 2014},
+					{Name: "栾城区", Code: "130111", PostalCode: "051430", Since: 2014},
+					{Name: "井陉县", Code: "130121", PostalCode: "050300"},
+					{Name: "正定县", Code: "130123", PostalCode: "050800"},
+					{Name: "行唐县", Code: "130125", PostalCode: "050600"},
+					{Name: "灵寿县", Code: "130126", PostalCode: "050500"},
+					{Name: "高邑县", Code: "130127", PostalCode: "051330"},
+					{Name: "深泽县", Code: "130128", PostalCode: "052560"},
+					{Name: "赞皇县", Code: "130129", PostalCode: "051230"},
+					{Name: "无极县", Code: "130130", PostalCode: "052460"},
+					{Name: "平山县", Code: "130131", PostalCode: "050400"},
+					{Name: "元氏县", Code: "130132", PostalCode: "051130"},
+					{Name: "赵县", Code: "130133", PostalCode: "051530"},
+					{Name: "辛集市", Code: "130181", PostalCode: "052360"},
+					{Name: "晋州市", Code: "130183", PostalCode: "052260"},
+					{Name: "新乐市", Code: "130184", PostalCode: "050700"},
+				},
+			},
+			{
+				Name: "唐山市", Code: "130200", Population: 772, PhoneCode: "0315", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "路南区", Code: "130202", PostalCode: "063000"},
+					{Name: "路北区", Code: "130203", PostalCode: "063000"},
+					{Name: "古冶区", Code: "130204", PostalCode: "063100"},
+					{Name: "开平区", Code: "130205", PostalCode: "063021"},
+					{Name: "丰南区", Code: "130207", PostalCode: "063300"},
+					{Name: "丰润区", Code: "130208", PostalCode: "064000"},
+					{Name: "曹妃甸区", Code: "130209", PostalCode: "063200"},
+					{Name: "滦南县", Code: "130224", PostalCode: "063500"},
+					{Name: "乐亭县", Code: "130225", PostalCode: "063600"},
+					{Name: "迁西县", Code: "130227", PostalCode: "064300"},
+					{Name: "玉田县", Code: "130229", PostalCode: "064100"},
+					{Name: "遵化市", Code: "130281", PostalCode: "064200"},
+					{Name: "迁安市", Code: "130283", PostalCode: "064400"},
+					{Name: "滦州市", Code: "130284", PostalCode: "063700", Since: 2018},
+				},
+			},
+			{
+				Name: "秦皇岛市", Code: "130300", Population: 314, PhoneCode: "0335", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海港区", Code: "130302", PostalCode: "066000"},
+					{Name: "山海关区", Code: "130303", PostalCode: "066200"},
+					{Name: "北戴河区", Code: "130304", PostalCode: "066100"},
+					{Name: "抚宁区", Code: "130306", PostalCode: "066300"},
+					{Name: "青龙满族自治县", Code: "130321", PostalCode: "066500"},
+					{Name: "昌黎县", Code: "130322", PostalCode: "066600"},
+					{Name: "卢龙县", Code: "130324", PostalCode: "066400"},
+				},
+			},
+			{
+				Name: "邯郸市", Code: "130400", Population: 941, PhoneCode: "0310", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "邯山区", Code: "130402", PostalCode: "056000"},
+					{Name: "丛台区", Code: "130403", PostalCode: "056000"},
+					{Name: "复兴区", Code: "130404", PostalCode: "056000"},
+					{Name: "峰峰矿区", Code: "130406", PostalCode: "056200"},
+					{Name: "肥乡区", Code: "130407", PostalCode: "057550"},
+					{Name: "永年区", Code: "130408", PostalCode: "057150"},
+					{Name: "临漳县", Code: "130423", PostalCode: "056600"},
+					{Name: "成安县", Code: "130424", PostalCode: "056700"},
+					{Name: "大名县", Code: "130425", PostalCode: "056900"},
+					{Name: "涉县", Code: "130426", PostalCode: "056400"},
+					{Name: "磁县", Code: "130427", PostalCode: "056500"},
+					{Name: "邱县", Code: "130430", PostalCode: "057450"},
+					{Name: "鸡泽县", Code: "130431", PostalCode: "057350"},
+					{Name: "广平县", Code: "130432", PostalCode: "057650"},
+					{Name: "馆陶县", Code: "130433", PostalCode: "057750"},
+					{Name: "魏县", Code: "130434", PostalCode: "056800"},
+					{Name: "曲周县", Code: "130435", PostalCode: "057250"},
+					{Name: "武安市", Code: "130481", PostalCode: "056300"},
+				},
+			},
+			{
+				Name: "邢台市", Code: "130500", Population: 711, PhoneCode: "0319", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "襄都区", Code: "130502", PostalCode: "054000"},
+					{Name: "信都区", Code: "130503", PostalCode: "054000"},
+					{Name: "任泽区", Code: "130505", PostalCode: "055150", Since: 2020},
+					{Name: "南和区", Code: "130506", PostalCode: "054400", Since: 2020},
+					{Name: "临城县", Code: "130522", PostalCode: "054300"},
+					{Name: "内丘县", Code: "130523", PostalCode: "054200"},
+					{Name: "柏乡县", Code: "130524", PostalCode: "055450"},
+					{Name: "隆尧县", Code: "130525", PostalCode: "055350"},
+					{Name: "宁晋县", Code: "130528", PostalCode: "055550"},
+					{Name: "巨鹿县", Code: "130529", PostalCode: "055250"},
+					{Name: "新河县", Code: "130530", PostalCode: "051730"},
+					{Name: "广宗县", Code: "130531", PostalCode: "054600"},
+					{Name: "平乡县", Code: "130532", PostalCode: "054500"},
+					{Name: "威县", Code: "130533", PostalCode: "054700"},
+					{Name: "清河县", Code: "130534", PostalCode: "054800"},
+					{Name: "临西县", Code: "130535", PostalCode: "054900"},
+					{Name: "南宫市", Code: "130581", PostalCode: "051800"},
+					{Name: "沙河市", Code: "130582", PostalCode: "054100"},
+				},
+			},
+			{
+				Name: "保定市", Code: "130600", Population: 1154, PhoneCode: "0312", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "竞秀区", Code: "130602", PostalCode: "071000"},
+					{Name: "莲池区", Code: "130606", PostalCode: "071000"},
+					{Name: "满城区", Code: "130607", PostalCode: "072150", Since: 2015},
+					{Name: "清苑区", Code: "130608", PostalCode: "071100", Since: 2015},
+					{Name: "徐水区", Code: "130609", PostalCode: "072550", Since: 2015},
+					{Name: "涞水县", Code: "130623", PostalCode: "074100"},
+					{Name: "阜平县", Code: "130624", PostalCode: "073200"},
+					{Name: "定兴县", Code: "130626", PostalCode: "072650"},
+					{Name: "唐县", Code: "130627", PostalCode: "072350"},
+					{Name: "高阳县", Code: "130628", PostalCode: "071500"},
+					{Name: "容城县", Code: "130629", PostalCode: "071700"},
+					{Name: "涞源县", Code: "130630", PostalCode: "074300"},
+					{Name: "望都县", Code: "130631", PostalCode: "072450"},
+					{Name: "安新县", Code: "130632", PostalCode: "071600"},
+					{Name: "易县", Code: "130633", PostalCode: "074200"},
+					{Name: "曲阳县", Code: "130634", PostalCode: "073100"},
+					{Name: "蠡县", Code: "130635", PostalCode: "071400"},
+					{Name: "顺平县", Code: "130636", PostalCode: "072250"},
+					{Name: "博野县", Code: "130637", PostalCode: "071300"},
+					{Name: "雄县", Code: "130638", PostalCode: "071800"},
+					{Name: "涿州市", Code: "130681", PostalCode: "072750"},
+					{Name: "定州市", Code: "130682", PostalCode: "073000"},
+					{Name: "安国市", Code: "130683", PostalCode: "071200"},
+					{Name: "高碑店市", Code: "130684", PostalCode: "074000"},
+				},
+			},
+			{
+				Name: "张家口市", Code: "130700", Population: 412, PhoneCode: "0313", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "桥东区", Code: "130702", PostalCode: "075000"},
+					{Name: "桥西区", Code: "130703", PostalCode: "075000"},
+					{Name: "宣化区", Code: "130705", PostalCode: "075100"},
+					{Name: "下花园区", Code: "130706", PostalCode: "075300"},
+					{Name: "万全区", Code: "130708", PostalCode: "076250"},
+					{Name: "崇礼区", Code: "130709", PostalCode: "076350"},
+					{Name: "张北县", Code: "130722", PostalCode: "076450"},
+					{Name: "康保县", Code: "130723", PostalCode: "076650"},
+					{Name: "沽源县", Code: "130724", PostalCode: "076550"},
+					{Name: "尚义县", Code: "130725", PostalCode: "076750"},
+					{Name: "蔚县", Code: "130726", PostalCode: "075700"},
+					{Name: "阳原县", Code: "130727", PostalCode: "075800"},
+					{Name: "怀安县", Code: "130728", PostalCode: "076150"},
+					{Name: "怀来县", Code: "130730", PostalCode: "075400"},
+					{Name: "涿鹿县", Code: "130731", PostalCode: "075600"},
+					{Name: "赤城县", Code: "130732", PostalCode: "075500"},
+				},
+			},
+			{
+				Name: "承德市", Code: "130800", Population: 335, PhoneCode: "0314", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "双桥区", Code: "130802", PostalCode: "067000"},
+					{Name: "双滦区", Code: "130803", PostalCode: "067001"},
+					{Name: "鹰手营子矿区", Code: "130804", PostalCode: "067200"},
+					{Name: "承德县", Code: "130821", PostalCode: "067400"},
+					{Name: "兴隆县", Code: "130822", PostalCode: "067300"},
+					{Name: "滦平县", Code: "130824", PostalCode: "068250"},
+					{Name: "隆化县", Code: "130825", PostalCode: "068150"},
+					{Name: "丰宁满族自治县", Code: "130826", PostalCode: "068350"},
+					{Name: "宽城满族自治县", Code: "130827", PostalCode: "067600"},
+					{Name: "围场满族蒙古族自治县", Code: "130828", PostalCode: "068450"},
+					{Name: "平泉市", Code: "130881", PostalCode: "067500"},
+				},
+			},
+			{
+				Name: "沧州市", Code: "130900", Population: 730, PhoneCode: "0317", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新华区", Code: "130902", PostalCode: "061000"},
+					{Name: "运河区", Code: "130903", PostalCode: "061000"},
+					{Name: "沧县", Code: "130921", PostalCode: "061000"},
+					{Name: "青县", Code: "130922", PostalCode: "062650"},
+					{Name: "东光县", Code: "130923", PostalCode: "061600"},
+					{Name: "海兴县", Code: "130924", PostalCode: "061200"},
+					{Name: "盐山县", Code: "130925", PostalCode: "061300"},
+					{Name: "肃宁县", Code: "130926", PostalCode: "062350"},
+					{Name: "南皮县", Code: "130927", PostalCode: "061500"},
+					{Name: "吴桥县", Code: "130928", PostalCode: "061800"},
+					{Name: "献县", Code: "130929", PostalCode: "062250"},
+					{Name: "孟村回族自治县", Code: "130930", PostalCode: "061400"},
+					{Name: "泊头市", Code: "130981", PostalCode: "062150"},
+					{Name: "任丘市", Code: "130982", PostalCode: "062550"},
+					{Name: "黄骅市", Code: "130983", PostalCode: "061100"},
+					{Name: "河间市", Code: "130984", PostalCode: "062450"},
+				},
+			},
+			{
+				Name: "廊坊市", Code: "131000", Population: 546, PhoneCode: "0316", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "安次区", Code: "131002", PostalCode: "065000"},
+					{Name: "广阳区", Code: "131003", PostalCode: "065000"},
+					{Name: "固安县", Code: "131022", PostalCode: "065500"},
+					{Name: "永清县", Code: "131023", PostalCode: "065600"},
+					{Name: "香河县", Code: "131024", PostalCode: "065400"},
+					{Name: "大城县", Code: "131025", PostalCode: "065900"},
+					{Name: "文安县", Code: "131026", PostalCode: "065800"},
+					{Name: "大厂回族自治县", Code: "131028", PostalCode: "065300"},
+					{Name: "霸州市", Code: "131081", PostalCode: "065700"},
+					{Name: "三河市", Code: "131082", PostalCode: "065200"},
+				},
+			},
+			{
+				Name: "衡水市", Code: "131100", Population: 421, PhoneCode: "0318", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "桃城区", Code: "131102", PostalCode: "053000"},
+					{Name: "冀州区", Code: "131103", PostalCode: "053200"},
+					{Name: "枣强县", Code: "131121", PostalCode: "053100"},
+					{Name: "武邑县", Code: "131122", PostalCode: "053400"},
+					{Name: "武强县", Code: "131123", PostalCode: "053300"},
+					{Name: "饶阳县", Code: "131124", PostalCode: "053900"},
+					{Name: "安平县", Code: "131125", PostalCode: "053600"},
+					{Name: "故城县", Code: "131126", PostalCode: "253800"},
+					{Name: "景县", Code: "131127", PostalCode: "053500"},
+					{Name: "阜城县", Code: "131128", PostalCode: "053700"},
+					{Name: "深州市", Code: "131182", PostalCode: "053800"},
+				},
+			},
 		},
 	},
 	{
-		Name: "山西省", Short: "山西", Code: "14",
+		Name: "山西省", Short: "山西", Abbr: "晋", Code: "14", Population: 3492,
 		Cities: []City{
-			{Name: "太原市", AreaCodes: []string{"140100", "140105"}},
-			{Name: "大同市", AreaCodes: []string{"140200"}},
-			{Name: "阳泉市", AreaCodes: []string{"140300"}},
-			{Name: "长治市", AreaCodes: []string{"140400"}},
-			{Name: "晋城市", AreaCodes: []string{"140500"}},
-			{Name: "运城市", AreaCodes: []string{"140800"}},
+			{
+				Name: "太原市", Code: "140100", Population: 530, PhoneCode: "0351", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "小店区", Code: "140105", PostalCode: "030000"},
+					{Name: "迎泽区", Code: "140106", PostalCode: "030000"},
+					{Name: "杏花岭区", Code: "140107", PostalCode: "030000"},
+					{Name: "尖草坪区", Code: "140108", PostalCode: "030000"},
+					{Name: "万柏林区", Code: "140109", PostalCode: "030000"},
+					{Name: "晋源区", Code: "140110", PostalCode: "030000"},
+					{Name: "清徐县", Code: "140121", PostalCode: "030400"},
+					{Name: "阳曲县", Code: "140122", PostalCode: "030100"},
+					{Name: "娄烦县", Code: "140123", PostalCode: "030300"},
+					{Name: "古交市", Code: "140181", PostalCode: "030200"},
+				},
+			},
+			{
+				Name: "大同市", Code: "140200", Population: 311, PhoneCode: "0352", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新荣区", Code: "140212", PostalCode: "037002"},
+					{Name: "平城区", Code: "140213", PostalCode: "037000", Since: 2018},
+					{Name: "云冈区", Code: "140214", PostalCode: "037000", Since: 2018},
+					{Name: "云州区", Code: "140215", PostalCode: "037300", Since: 2018},
+					{Name: "阳高县", Code: "140221", PostalCode: "038100"},
+					{Name: "天镇县", Code: "140222", PostalCode: "038200"},
+					{Name: "广灵县", Code: "140223", PostalCode: "037500"},
+					{Name: "灵丘县", Code: "140224", PostalCode: "034400"},
+					{Name: "浑源县", Code: "140225", PostalCode: "037400"},
+					{Name: "左云县", Code: "140226", PostalCode: "037100"},
+				},
+			},
+			{
+				Name: "阳泉市", Code: "140300", Population: 132, PhoneCode: "0353", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城区", Code: "140302", PostalCode: "045000"},
+					{Name: "矿区", Code: "140303", PostalCode: "045000"},
+					{Name: "郊区", Code: "140311", PostalCode: "045000"},
+					{Name: "平定县", Code: "140321", PostalCode: "045200"},
+					{Name: "盂县", Code: "140322", PostalCode: "045100"},
+				},
+			},
+			{
+				Name: "长治市", Code: "140400", Population: 318, PhoneCode: "0355", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "潞州区", Code: "140403", PostalCode: "046000", Since: 2018},
+					{Name: "上党区", Code: "140404", PostalCode: "047100", Since: 2018},
+					{Name: "屯留区", Code: "140405", PostalCode: "046100", Since: 2018},
+					{Name: "潞城区", Code: "140406", PostalCode: "047500", Since: 2018},
+					{Name: "襄垣县", Code: "140423", PostalCode: "046200"},
+					{Name: "平顺县", Code: "140425", PostalCode: "047400"},
+					{Name: "黎城县", Code: "140426", PostalCode: "047600"},
+					{Name: "壶关县", Code: "140427", PostalCode: "047300"},
+					{Name: "长子县", Code: "140428", PostalCode: "046600"},
+					{Name: "武乡县", Code: "140429", PostalCode: "046300"},
+					{Name: "沁县", Code: "140430", PostalCode: "046400"},
+					{Name: "沁源县", Code: "140431", PostalCode: "046500"},
+				},
+			},
+			{
+				Name: "晋城市", Code: "140500", Population: 219, PhoneCode: "0356", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城区", Code: "140502", PostalCode: "048000"},
+					{Name: "沁水县", Code: "140521", PostalCode: "048200"},
+					{Name: "阳城县", Code: "140522", PostalCode: "048100"},
+					{Name: "陵川县", Code: "140524", PostalCode: "048300"},
+					{Name: "泽州县", Code: "140525", PostalCode: "048012"},
+					{Name: "高平市", Code: "140581", PostalCode: "048400"},
+				},
+			},
+			{
+				Name: "朔州市", Code: "140600", Population: 159, PhoneCode: "0349", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "朔城区", Code: "140602", PostalCode: "036000"},
+					{Name: "平鲁区", Code: "140603", PostalCode: "038600"},
+					{Name: "山阴县", Code: "140621", PostalCode: "036900"},
+					{Name: "应县", Code: "140622", PostalCode: "037600"},
+					{Name: "右玉县", Code: "140623", PostalCode: "037200"},
+					{Name: "怀仁市", Code: "140681", PostalCode: "038300", Since: 2018},
+				},
+			},
+			{
+				Name: "晋中市", Code: "140700", Population: 338, PhoneCode: "0354", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "榆次区", Code: "140702", PostalCode: "030600"},
+					{Name: "太谷区", Code: "140703", PostalCode: "030800", Since: 2019},
+					{Name: "榆社县", Code: "140721", PostalCode: "031800"},
+					{Name: "左权县", Code: "140722", PostalCode: "032600"},
+					{Name: "和顺县", Code: "140723", PostalCode: "032700"},
+					{Name: "昔阳县", Code: "140724", PostalCode: "045300"},
+					{Name: "寿阳县", Code: "140725", PostalCode: "045400"},
+					{Name: "祁县", Code: "140727", PostalCode: "030900"},
+					{Name: "平遥县", Code: "140728", PostalCode: "031100"},
+					{Name: "灵石县", Code: "140729", PostalCode: "031300"},
+					{Name: "介休市", Code: "140781", PostalCode: "032000"},
+				},
+			},
+			{
+				Name: "运城市", Code: "140800", Population: 477, PhoneCode: "0359", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "盐湖区", Code: "140802", PostalCode: "044000"},
+					{Name: "临猗县", Code: "140821", PostalCode: "044100"},
+					{Name: "万荣县", Code: "140822", PostalCode: "044200"},
+					{Name: "闻喜县", Code: "140823", PostalCode: "043800"},
+					{Name: "稷山县", Code: "140824", PostalCode: "043200"},
+					{Name: "新绛县", Code: "140825", PostalCode: "043100"},
+					{Name: "绛县", Code: "140826", PostalCode: "043600"},
+					{Name: "垣曲县", Code: "140827", PostalCode: "043700"},
+					{Name: "夏县", Code: "140828", PostalCode: "044400"},
+					{Name: "平陆县", Code: "140829", PostalCode: "044300"},
+					{Name: "芮城县", Code: "140830", PostalCode: "044600"},
+					{Name: "永济市", Code: "140881", PostalCode: "044500"},
+					{Name: "河津市", Code: "140882", PostalCode: "043300"},
+				},
+			},
+			{
+				Name: "忻州市", Code: "140900", Population: 268, PhoneCode: "0350", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "忻府区", Code: "140902", PostalCode: "034000"},
+					{Name: "定襄县", Code: "140921", PostalCode: "035400"},
+					{Name: "五台县", Code: "140922", PostalCode: "035500"},
+					{Name: "代县", Code: "140923", PostalCode: "034200"},
+					{Name: "繁峙县", Code: "140924", PostalCode: "034300"},
+					{Name: "宁武县", Code: "140925", PostalCode: "036700"},
+					{Name: "静乐县", Code: "140926", PostalCode: "035100"},
+					{Name: "神池县", Code: "140927", PostalCode: "036100"},
+					{Name: "五寨县", Code: "140928", PostalCode: "036200"},
+					{Name: "岢岚县", Code: "140929", PostalCode: "036300"},
+					{Name: "河曲县", Code: "140930", PostalCode: "036500"},
+					{Name: "保德县", Code: "140931", PostalCode: "036600"},
+					{Name: "偏关县", Code: "140932", PostalCode: "036400"},
+					{Name: "原平市", Code: "140981", PostalCode: "034100"},
+				},
+			},
+			{
+				Name: "临汾市", Code: "141000", Population: 398, PhoneCode: "0357", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "尧都区", Code: "141002", PostalCode: "041000"},
+					{Name: "曲沃县", Code: "141021", PostalCode: "043400"},
+					{Name: "翼城县", Code: "141022", PostalCode: "043500"},
+					{Name: "襄汾县", Code: "141023", PostalCode: "041500"},
+					{Name: "洪洞县", Code: "141024", PostalCode: "041600"},
+					{Name: "古县", Code: "141025", PostalCode: "042400"},
+					{Name: "安泽县", Code: "141026", PostalCode: "042500"},
+					{Name: "浮山县", Code: "141027", PostalCode: "042600"},
+					{Name: "吉县", Code: "141028", PostalCode: "042200"},
+					{Name: "乡宁县", Code: "141029", PostalCode: "042100"},
+					{Name: "大宁县", Code: "141030", PostalCode: "042300"},
+					{Name: "隰县", Code: "141031", PostalCode: "041300"},
+					{Name: "永和县", Code: "141032", PostalCode: "041400"},
+					{Name: "蒲县", Code: "141033", PostalCode: "041200"},
+					{Name: "汾西县", Code: "141034", PostalCode: "031500"},
+					{Name: "侯马市", Code: "141081", PostalCode: "043000"},
+					{Name: "霍州市", Code: "141082", PostalCode: "031400"},
+				},
+			},
+			{
+				Name: "吕梁市", Code: "141100", Population: 340, PhoneCode: "0358", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "离石区", Code: "141102", PostalCode: "033000"},
+					{Name: "文水县", Code: "141121", PostalCode: "032100"},
+					{Name: "交城县", Code: "141122", PostalCode: "030500"},
+					{Name: "兴县", Code: "141123", PostalCode: "033600"},
+					{Name: "临县", Code: "141124", PostalCode: "033200"},
+					{Name: "柳林县", Code: "141125", PostalCode: "033300"},
+					{Name: "石楼县", Code: "141126", PostalCode: "032500"},
+					{Name: "岚县", Code: "141127", PostalCode: "033500"},
+					{Name: "方山县", Code: "141128", PostalCode: "033100"},
+					{Name: "中阳县", Code: "141129", PostalCode: "033400"},
+					{Name: "交口县", Code: "141130", PostalCode: "032400"},
+					{Name: "孝义市", Code: "141181", PostalCode: "032300"},
+					{Name: "汾阳市", Code: "141182", PostalCode: "032200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "内蒙古自治区", Short: "内蒙古", Code: "15",
+		Name: "内蒙古自治区", Short: "内蒙古", Abbr: "蒙", Code: "15", Population: 2405,
 		Cities: []City{
-			{Name: "呼和浩特市", AreaCodes: []string{"150100", "150102"}},
-			{Name: "包头市", AreaCodes: []string{"150200"}},
-			{Name: "乌海市", AreaCodes: []string{"150300"}},
-			{Name: "赤峰市", AreaCodes: []string{"150400"}},
-			{Name: "鄂尔多斯市", AreaCodes: []string{"150600"}},
+			{
+				Name: "呼和浩特市", Code: "150100", Population: 345, PhoneCode: "0471", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新城区", Code: "150102", PostalCode: "010000"},
+					{Name: "回民区", Code: "150103", PostalCode: "010000"},
+					{Name: "玉泉区", Code: "150104", PostalCode: "010000"},
+					{Name: "赛罕区", Code: "150105", PostalCode: "010000"},
+					{Name: "土默特左旗", Code: "150121", PostalCode: "010100"},
+					{Name: "托克托县", Code: "150122", PostalCode: "010200"},
+					{Name: "和林格尔县", Code: "150123", PostalCode: "011500"},
+					{Name: "清水河县", Code: "150124", PostalCode: "011600"},
+					{Name: "武川县", Code: "150125", PostalCode: "011700"},
+				},
+			},
+			{
+				Name: "包头市", Code: "150200", Population: 271, PhoneCode: "0472", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东河区", Code: "150202", PostalCode: "014040"},
+					{Name: "昆都仑区", Code: "150203", PostalCode: "014010"},
+					{Name: "青山区", Code: "150204", PostalCode: "014030"},
+					{Name: "石拐区", Code: "150205", PostalCode: "014070"},
+					{Name: "白云鄂博矿区", Code: "150206", PostalCode: "014080"},
+					{Name: "九原区", Code: "150207", PostalCode: "014060"},
+					{Name: "土默特右旗", Code: "150221", PostalCode: "014100"},
+					{Name: "固阳县", Code: "150222", PostalCode: "014200"},
+					{Name: "达尔罕茂明安联合旗", Code: "150223", PostalCode: "014500"},
+				},
+			},
+			{
+				Name: "乌海市", Code: "150300", Population: 56, PhoneCode: "0473", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海勃湾区", Code: "150302", PostalCode: "016000"},
+					{Name: "海南区", Code: "150303", PostalCode: "016030"},
+					{Name: "乌达区", Code: "150304", PostalCode: "016040"},
+				},
+			},
+			{
+				Name: "赤峰市", Code: "150400", Population: 404, PhoneCode: "0476", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "红山区", Code: "150402", PostalCode: "024000"},
+					{Name: "元宝山区", Code: "150403", PostalCode: "024076"},
+					{Name: "松山区", Code: "150404", PostalCode: "024005"},
+					{Name: "阿鲁科尔沁旗", Code: "150421", PostalCode: "025500"},
+					{Name: "巴林左旗", Code: "150422", PostalCode: "025450"},
+					{Name: "巴林右旗", Code: "150423", PostalCode: "025150"},
+					{Name: "林西县", Code: "150424", PostalCode: "025250"},
+					{Name: "克什克腾旗", Code: "150425", PostalCode: "025350"},
+					{Name: "翁牛特旗", Code: "150426", PostalCode: "024500"},
+					{Name: "喀喇沁旗", Code: "150428", PostalCode: "024400"},
+					{Name: "宁城县", Code: "150429", PostalCode: "024200"},
+					{Name: "敖汉旗", Code: "150430", PostalCode: "024300"},
+				},
+			},
+			{
+				Name: "通辽市", Code: "150500", Population: 287, PhoneCode: "0475", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "科尔沁区", Code: "150502", PostalCode: "028000"},
+					{Name: "科尔沁左翼中旗", Code: "150521", PostalCode: "029300"},
+					{Name: "科尔沁左翼后旗", Code: "150522", PostalCode: "028100"},
+					{Name: "开鲁县", Code: "150523", PostalCode: "028400"},
+					{Name: "库伦旗", Code: "150524", PostalCode: "028200"},
+					{Name: "奈曼旗", Code: "150525", PostalCode: "028300"},
+					{Name: "扎鲁特旗", Code: "150526", PostalCode: "029100"},
+					{Name: "霍林郭勒市", Code: "150581", PostalCode: "029200"},
+				},
+			},
+			{
+				Name: "鄂尔多斯市", Code: "150600", Population: 216, PhoneCode: "0477", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东胜区", Code: "150602", PostalCode: "017000"},
+					{Name: "康巴什区", Code: "150603", PostalCode: "017010"},
+					{Name: "达拉特旗", Code: "150621", PostalCode: "014300"},
+					{Name: "准格尔旗", Code: "150622", PostalCode: "017100"},
+					{Name: "鄂托克前旗", Code: "150623", PostalCode: "016200"},
+					{Name: "鄂托克旗", Code: "150624", PostalCode: "016100"},
+					{Name: "杭锦旗", Code: "150625", PostalCode: "017400"},
+					{Name: "乌审旗", Code: "150626", PostalCode: "017300"},
+					{Name: "伊金霍洛旗", Code: "150627", PostalCode: "017200"},
+				},
+			},
+			{
+				Name: "呼伦贝尔市", Code: "150700", Population: 224, PhoneCode: "0470", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海拉尔区", Code: "150702", PostalCode: "021000"},
+					{Name: "扎赉诺尔区", Code: "150703", PostalCode: "021410"},
+					{Name: "阿荣旗", Code: "150721", PostalCode: "162750"},
+					{Name: "莫力达瓦达斡尔族自治旗", Code: "150722", PostalCode: "162850"},
+					{Name: "鄂伦春自治旗", Code: "150723", PostalCode: "165450"},
+					{Name: "鄂温克族自治旗", Code: "150724", PostalCode: "021100"},
+					{Name: "陈巴尔虎旗", Code: "150725", PostalCode: "021500"},
+					{Name: "新巴尔虎左旗", Code: "150726", PostalCode: "021200"},
+					{Name: "新巴尔虎右旗", Code: "150727", PostalCode: "021300"},
+					{Name: "满洲里市", Code: "150781", PostalCode: "021400"},
+					{Name: "牙克石市", Code: "150782", PostalCode: "022150"},
+					{Name: "扎兰屯市", Code: "150783", PostalCode: "162650"},
+					{Name: "额尔古纳市", Code: "150784", PostalCode: "022250"},
+					{Name: "根河市", Code: "150785", PostalCode: "022350"},
+				},
+			},
+			{
+				Name: "巴彦淖尔市", Code: "150800", Population: 154, PhoneCode: "0478", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "临河区", Code: "150802", PostalCode: "015000"},
+					{Name: "五原县", Code: "150821", PostalCode: "015100"},
+					{Name: "磴口县", Code: "150822", PostalCode: "015200"},
+					{Name: "乌拉特前旗", Code: "150823", PostalCode: "014400"},
+					{Name: "乌拉特中旗", Code: "150824", PostalCode: "015300"},
+					{Name: "乌拉特后旗", Code: "150825", PostalCode: "015500"},
+					{Name: "杭锦后旗", Code: "150826", PostalCode: "015400"},
+				},
+			},
+			{
+				Name: "乌兰察布市", Code: "150900", Population: 171, PhoneCode: "0474", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "集宁区", Code: "150902", PostalCode: "012000"},
+					{Name: "卓资县", Code: "150921", PostalCode: "012300"},
+					{Name: "化德县", Code: "150922", PostalCode: "013350"},
+					{Name: "商都县", Code: "150923", PostalCode: "013450"},
+					{Name: "兴和县", Code: "150924", PostalCode: "013650"},
+					{Name: "凉城县", Code: "150925", PostalCode: "013750"},
+					{Name: "察哈尔右翼前旗", Code: "150926", PostalCode: "012200"},
+					{Name: "察哈尔右翼中旗", Code: "150927", PostalCode: "013550"},
+					{Name: "察哈尔右翼后旗", Code: "150928", PostalCode: "012400"},
+					{Name: "四子王旗", Code: "150929", PostalCode: "011800"},
+					{Name: "丰镇市", Code: "150981", PostalCode: "012100"},
+				},
+			},
+			{
+				Name: "兴安盟", Code: "152200", Population: 142, PhoneCode: "0482", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "乌兰浩特市", Code: "152201", PostalCode: "137400"},
+					{Name: "阿尔山市", Code: "152202", PostalCode: "137800"},
+					{Name: "科尔沁右翼前旗", Code: "152221", PostalCode: "137400"},
+					{Name: "科尔沁右翼中旗", Code: "152222", PostalCode: "029400"},
+					{Name: "扎赉特旗", Code: "152223", PostalCode: "137600"},
+					{Name: "突泉县", Code: "152224", PostalCode: "137500"},
+				},
+			},
+			{
+				Name: "锡林郭勒盟", Code: "152500", Population: 111, PhoneCode: "0479", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "二连浩特市", Code: "152501", PostalCode: "011100"},
+					{Name: "锡林浩特市", Code: "152502", PostalCode: "026000"},
+					{Name: "阿巴嘎旗", Code: "152522", PostalCode: "011400"},
+					{Name: "苏尼特左旗", Code: "152523", PostalCode: "011300"},
+					{Name: "苏尼特右旗", Code: "152524", PostalCode: "011200"},
+					{Name: "东乌珠穆沁旗", Code: "152525", PostalCode: "026300"},
+					{Name: "西乌珠穆沁旗", Code: "152526", PostalCode: "026200"},
+					{Name: "太仆寺旗", Code: "152527", PostalCode: "027000"},
+					{Name: "镶黄旗", Code: "152528", PostalCode: "013250"},
+					{Name: "正镶白旗", Code: "152529", PostalCode: "013800"},
+					{Name: "正蓝旗", Code: "152530", PostalCode: "027200"},
+					{Name: "多伦县", Code: "152531", PostalCode: "027300"},
+				},
+			},
+			{
+				Name: "阿拉善盟", Code: "152900", Population: 26, PhoneCode: "0483", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "阿拉善左旗", Code: "152921", PostalCode: "750300"},
+					{Name: "阿拉善右旗", Code: "152922", PostalCode: "737300"},
+					{Name: "额济纳旗", Code: "152923", PostalCode: "735400"},
+				},
+			},
 		},
 	},
 	{
-		Name: "辽宁省", Short: "辽宁", Code: "21",
+		Name: "辽宁省", Short: "辽宁", Abbr: "辽", Code: "21", Population: 4259,
 		Cities: []City{
-			{Name: "沈阳市", AreaCodes: []string{"210100", "210102"}},
-			{Name: "大连市", AreaCodes: []string{"210200", "210202"}},
-			{Name: "鞍山市", AreaCodes: []string{"210300"}},
-			{Name: "抚顺市", AreaCodes: []string{"210400"}},
-			{Name: "本溪市", AreaCodes: []string{"210500"}},
-			{Name: "丹东市", AreaCodes: []string{"210600"}},
+			{
+				Name: "沈阳市", Code: "210100", Population: 907, PhoneCode: "024", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "和平区", Code: "210102", PostalCode: "110000"},
+					{Name: "沈河区", Code: "210103", PostalCode: "110000"},
+					{Name: "大东区", Code: "210104", PostalCode: "110000"},
+					{Name: "皇姑区", Code: "210105", PostalCode: "110000"},
+					{Name: "铁西区", Code: "210106", PostalCode: "110000"},
+					{Name: "苏家屯区", Code: "210111", PostalCode: "110101"},
+					{Name: "浑南区", Code: "210112", PostalCode: "110015"},
+					{Name: "沈北新区", Code: "210113", PostalCode: "110121"},
+					{Name: "于洪区", Code: "210114", PostalCode: "110141"},
+					{Name: "辽中区", Code: "210115", PostalCode: "110200", Since: 2016},
+					{Name: "康平县", Code: "210123", PostalCode: "110500"},
+					{Name: "法库县", Code: "210124", PostalCode: "110400"},
+					{Name: "新民市", Code: "210181", PostalCode: "110300"},
+				},
+			},
+			{
+				Name: "大连市", Code: "210200", Population: 745, PhoneCode: "0411", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "中山区", Code: "210202", PostalCode: "116001"},
+					{Name: "西岗区", Code: "210203", PostalCode: "116011"},
+					{Name: "沙河口区", Code: "210204", PostalCode: "116021"},
+					{Name: "甘井子区", Code: "210211", PostalCode: "116033"},
+					{Name: "旅顺口区", Code: "210212", PostalCode: "116041"},
+					{Name: "金州区", Code: "210213", PostalCode: "116100"},
+					{Name: "普兰店区", Code: "210214", PostalCode: "116200", Since: 2015},
+					{Name: "长海县", Code: "210224", PostalCode: "116500"},
+					{Name: "瓦房店市", Code: "210281", PostalCode: "116300"},
+					{Name: "庄河市", Code: "210283", PostalCode: "116400"},
+				},
+			},
+			{
+				Name: "鞍山市", Code: "210300", Population: 333, PhoneCode: "0412", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "铁东区", Code: "210302", PostalCode: "114000"},
+					{Name: "铁西区", Code: "210303", PostalCode: "114000"},
+					{Name: "立山区", Code: "210304", PostalCode: "114000"},
+					{Name: "千山区", Code: "210311", PostalCode: "114041"},
+					{Name: "台安县", Code: "210321", PostalCode: "114100"},
+					{Name: "岫岩满族自治县", Code: "210323", PostalCode: "114300"},
+					{Name: "海城市", Code: "210381", PostalCode: "114200"},
+				},
+			},
+			{
+				Name: "抚顺市", Code: "210400", Population: 186, PhoneCode: "024", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "新抚区", Code: "210402", PostalCode: "113000"},
+					{Name: "东洲区", Code: "210403", PostalCode: "113000"},
+					{Name: "望花区", Code: "210404", PostalCode: "113000"},
+					{Name: "顺城区", Code: "210411", PostalCode: "113000"},
+					{Name: "抚顺县", Code: "210421", PostalCode: "113006"},
+					{Name: "新宾满族自治县", Code: "210422", PostalCode: "113200"},
+					{Name: "清原满族自治县", Code: "210423", PostalCode: "113300"},
+				},
+			},
+			{
+				Name: "本溪市", Code: "210500", Population: 133, PhoneCode: "024", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "平山区", Code: "210502", PostalCode: "117000"},
+					{Name: "溪湖区", Code: "210503", PostalCode: "117000"},
+					{Name: "明山区", Code: "210504", PostalCode: "117000"},
+					{Name: "南芬区", Code: "210505", PostalCode: "117014"},
+					{Name: "本溪满族自治县", Code: "210521", PostalCode: "117100"},
+					{Name: "桓仁满族自治县", Code: "210522", PostalCode: "117200"},
+				},
+			},
+			{
+				Name: "丹东市", Code: "210600", Population: 219, PhoneCode: "0415", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "元宝区", Code: "210602", PostalCode: "118000"},
+					{Name: "振兴区", Code: "210603", PostalCode: "118000"},
+					{Name: "振安区", Code: "210604", PostalCode: "118000"},
+					{Name: "宽甸满族自治县", Code: "210624", PostalCode: "118200"},
+					{Name: "东港市", Code: "210681", PostalCode: "118300"},
+					{Name: "凤城市", Code: "210682", PostalCode: "118100"},
+				},
+			},
+			{
+				Name: "锦州市", Code: "210700", Population: 270, PhoneCode: "0416", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "古塔区", Code: "210702", PostalCode: "121000"},
+					{Name: "凌河区", Code: "210703", PostalCode: "121000"},
+					{Name: "太和区", Code: "210711", PostalCode: "121000"},
+					{Name: "黑山县", Code: "210726", PostalCode: "121400"},
+					{Name: "义县", Code: "210727", PostalCode: "121100"},
+					{Name: "凌海市", Code: "210781", PostalCode: "121200"},
+					{Name: "北镇市", Code: "210782", PostalCode: "121300"},
+				},
+			},
+			{
+				Name: "营口市", Code: "210800", Population: 233, PhoneCode: "0417", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "站前区", Code: "210802", PostalCode: "115000"},
+					{Name: "西市区", Code: "210803", PostalCode: "115000"},
+					{Name: "鲅鱼圈区", Code: "210804", PostalCode: "115007"},
+					{Name: "老边区", Code: "210811", PostalCode: "115005"},
+					{Name: "盖州市", Code: "210881", PostalCode: "115200"},
+					{Name: "大石桥市", Code: "210882", PostalCode: "115100"},
+				},
+			},
+			{
+				Name: "阜新市", Code: "210900", Population: 165, PhoneCode: "0418", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海州区", Code: "210902", PostalCode: "123000"},
+					{Name: "新邱区", Code: "210903", PostalCode: "123000"},
+					{Name: "太平区", Code: "210904", PostalCode: "123000"},
+					{Name: "清河门区", Code: "210905", PostalCode: "123000"},
+					{Name: "细河区", Code: "210911", PostalCode: "123000"},
+					{Name: "阜新蒙古族自治县", Code: "210921", PostalCode: "123100"},
+					{Name: "彰武县", Code: "210922", PostalCode: "123200"},
+				},
+			},
+			{
+				Name: "辽阳市", Code: "211000", Population: 160, PhoneCode: "0419", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "白塔区", Code: "211002", PostalCode: "111000"},
+					{Name: "文圣区", Code: "211003", PostalCode: "111000"},
+					{Name: "宏伟区", Code: "211004", PostalCode: "111000"},
+					{Name: "弓长岭区", Code: "211005", PostalCode: "111008"},
+					{Name: "太子河区", Code: "211011", PostalCode: "111000"},
+					{Name: "辽阳县", Code: "211021", PostalCode: "111200"},
+					{Name: "灯塔市", Code: "211081", PostalCode: "111300"},
+				},
+			},
+			{
+				Name: "盘锦市", Code: "211100", Population: 139, PhoneCode: "0427", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "双台子区", Code: "211102", PostalCode: "124000"},
+					{Name: "兴隆台区", Code: "211103", PostalCode: "124000"},
+					{Name: "大洼区", Code: "211104", PostalCode: "124200"},
+					{Name: "盘山县", Code: "211122", PostalCode: "124000"},
+				},
+			},
+			{
+				Name: "铁岭市", Code: "211200", Population: 239, PhoneCode: "024", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "银州区", Code: "211202", PostalCode: "112000"},
+					{Name: "清河区", Code: "211204", PostalCode: "112000"},
+					{Name: "铁岭县", Code: "211221", PostalCode: "112000"},
+					{Name: "西丰县", Code: "211223", PostalCode: "112400"},
+					{Name: "昌图县", Code: "211224", PostalCode: "112500"},
+					{Name: "调兵山市", Code: "211281", PostalCode: "112700"},
+					{Name: "开原市", Code: "211282", PostalCode: "112300"},
+				},
+			},
+			{
+				Name: "朝阳市", Code: "211300", Population: 287, PhoneCode: "0421", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "双塔区", Code: "211302", PostalCode: "122000"},
+					{Name: "龙城区", Code: "211303", PostalCode: "122000"},
+					{Name: "朝阳县", Code: "211321", PostalCode: "122000"},
+					{Name: "建平县", Code: "211322", PostalCode: "122400"},
+					{Name: "喀喇沁左翼蒙古族自治县", Code: "211324", PostalCode: "122300"},
+					{Name: "北票市", Code: "211381", PostalCode: "122100"},
+					{Name: "凌源市", Code: "211382", PostalCode: "122500"},
+				},
+			},
+			{
+				Name: "葫芦岛市", Code: "211400", Population: 243, PhoneCode: "0429", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "连山区", Code: "211402", PostalCode: "125000"},
+					{Name: "龙港区", Code: "211403", PostalCode: "125000"},
+					{Name: "南票区", Code: "211404", PostalCode: "125000"},
+					{Name: "绥中县", Code: "211421", PostalCode: "125200"},
+					{Name: "建昌县", Code: "211422", PostalCode: "125300"},
+					{Name: "兴城市", Code: "211481", PostalCode: "125100"},
+				},
+			},
 		},
 	},
 	{
-		Name: "吉林省", Short: "吉林", Code: "22",
+		Name: "吉林省", Short: "吉林", Abbr: "吉", Code: "22", Population: 2407,
 		Cities: []City{
-			{Name: "长春市", AreaCodes: []string{"220100", "220102"}},
-			{Name: "吉林市", AreaCodes: []string{"220200"}},
-			{Name: "四平市", AreaCodes: []string{"220300"}},
-			{Name: "辽源市", AreaCodes: []string{"220400"}},
-			{Name: "通化市", AreaCodes: []string{"220500"}},
+			{
+				Name: "长春市", Code: "220100", Population: 907, PhoneCode: "0431", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "南关区", Code: "220102", PostalCode: "130000"},
+					{Name: "宽城区", Code: "220103", PostalCode: "130000"},
+					{Name: "朝阳区", Code: "220104", PostalCode: "130000"},
+					{Name: "二道区", Code: "220105", PostalCode: "130000"},
+					{Name: "绿园区", Code: "220106", PostalCode: "130000"},
+					{Name: "双阳区", Code: "220112", PostalCode: "130600"},
+					{Name: "九台区", Code: "220113", PostalCode: "130500", Since: 2014},
+					{Name: "农安县", Code: "220122", PostalCode: "130200"},
+					{Name: "榆树市", Code: "220182", PostalCode: "130400"},
+					{Name: "德惠市", Code: "220183", PostalCode: "130300"},
+					{Name: "公主岭市", Code: "220184", PostalCode: "136100", Since: 2020},
+				},
+			},
+			{
+				Name: "吉林市", Code: "220200", Population: 362, PhoneCode: "0432", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "昌邑区", Code: "220202", PostalCode: "132000"},
+					{Name: "龙潭区", Code: "220203", PostalCode: "132000"},
+					{Name: "船营区", Code: "220204", PostalCode: "132000"},
+					{Name: "丰满区", Code: "220211", PostalCode: "132000"},
+					{Name: "永吉县", Code: "220221", PostalCode: "132100"},
+					{Name: "蛟河市", Code: "220281", PostalCode: "132500"},
+					{Name: "桦甸市", Code: "220282", PostalCode: "132400"},
+					{Name: "舒兰市", Code: "220283", PostalCode: "132600"},
+					{Name: "磐石市", Code: "220284", PostalCode: "132300"},
+				},
+			},
+			{
+				Name: "四平市", Code: "220300", Population: 181, PhoneCode: "0434", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "铁西区", Code: "220302", PostalCode: "136000"},
+					{Name: "铁东区", Code: "220303", PostalCode: "136000"},
+					{Name: "梨树县", Code: "220322", PostalCode: "136500"},
+					{Name: "伊通满族自治县", Code: "220323", PostalCode: "130700"},
+					{Name: "双辽市", Code: "220382", PostalCode: "136400"},
+				},
+			},
+			{
+				Name: "辽源市", Code: "220400", Population: 100, PhoneCode: "0437", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "龙山区", Code: "220402", PostalCode: "136200"},
+					{Name: "西安区", Code: "220403", PostalCode: "136200"},
+					{Name: "东丰县", Code: "220421", PostalCode: "136300"},
+					{Name: "东辽县", Code: "220422", PostalCode: "136600"},
+				},
+			},
+			{
+				Name: "通化市", Code: "220500", Population: 182, PhoneCode: "0435", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东昌区", Code: "220502", PostalCode: "134000"},
+					{Name: "二道江区", Code: "220503", PostalCode: "134003"},
+					{Name: "通化县", Code: "220521", PostalCode: "134100"},
+					{Name: "辉南县", Code: "220523", PostalCode: "135100"},
+					{Name: "柳河县", Code: "220524", PostalCode: "135300"},
+					{Name: "梅河口市", Code: "220581", PostalCode: "135000"},
+					{Name: "集安市", Code: "220582", PostalCode: "134200"},
+				},
+			},
+			{
+				Name: "白山市", Code: "220600", Population: 95, PhoneCode: "0439", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "浑江区", Code: "220602", PostalCode: "134300"},
+					{Name: "江源区", Code: "220605", PostalCode: "134700"},
+					{Name: "抚松县", Code: "220621", PostalCode: "134500"},
+					{Name: "靖宇县", Code: "220622", PostalCode: "135200"},
+					{Name: "长白朝鲜族自治县", Code: "220623", PostalCode: "134400"},
+					{Name: "临江市", Code: "220681", PostalCode: "134600"},
+				},
+			},
+			{
+				Name: "松原市", Code: "220700", Population: 225, PhoneCode: "0438", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "宁江区", Code: "220702", PostalCode: "138000"},
+					{Name: "前郭尔罗斯蒙古族自治县", Code: "220721", PostalCode: "138000"},
+					{Name: "长岭县", Code: "220722", PostalCode: "131500"},
+					{Name: "乾安县", Code: "220723", PostalCode: "131400"},
+					{Name: "扶余市", Code: "220781", PostalCode: "131200"},
+				},
+			},
+			{
+				Name: "白城市", Code: "220800", Population: 155, PhoneCode: "0436", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "洮北区", Code: "220802", PostalCode: "137000"},
+					{Name: "镇赉县", Code: "220821", PostalCode: "137300"},
+					{Name: "通榆县", Code: "220822", PostalCode: "137200"},
+					{Name: "洮南市", Code: "220881", PostalCode: "137100"},
+					{Name: "大安市", Code: "220882", PostalCode: "131300"},
+				},
+			},
+			{
+				Name: "延边朝鲜族自治州", Code: "222400", Population: 194, PhoneCode: "0433", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "延吉市", Code: "222401", PostalCode: "133000"},
+					{Name: "图们市", Code: "222402", PostalCode: "133100"},
+					{Name: "敦化市", Code: "222403", PostalCode: "133700"},
+					{Name: "珲春市", Code: "222404", PostalCode: "133300"},
+					{Name: "龙井市", Code: "222405", PostalCode: "133400"},
+					{Name: "和龙市", Code: "222406", PostalCode: "133500"},
+					{Name: "汪清县", Code: "222424", PostalCode: "133200"},
+					{Name: "安图县", Code: "222426", PostalCode: "133600"},
+				},
+			},
 		},
 	},
 	{
-		Name: "黑龙江省", Short: "黑龙江", Code: "23",
+		Name: "黑龙江省", Short: "黑龙江", Abbr: "黑", Code: "23", Population: 3185,
 		Cities: []City{
-			{Name: "哈尔滨市", AreaCodes: []string{"230100", "230102"}},
-			{Name: "齐齐哈尔市", AreaCodes: []string{"230200"}},
-			{Name: "牡丹江市", AreaCodes: []string{"231000"}},
-			{Name: "佳木斯市", AreaCodes: []string{"230800"}},
-			{Name: "大庆市", AreaCodes: []string{"230600"}},
+			{
+				Name: "哈尔滨市", Code: "230100", Population: 1001, PhoneCode: "0451", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "道里区", Code: "230102", PostalCode: "150000"},
+					{Name: "南岗区", Code: "230103", PostalCode: "150000"},
+					{Name: "道外区", Code: "230104", PostalCode: "150000"},
+					{Name: "平房区", Code: "230108", PostalCode: "150000"},
+					{Name: "松北区", Code: "230109", PostalCode: "150000"},
+					{Name: "香坊区", Code: "230110", PostalCode: "150000"},
+					{Name: "呼兰区", Code: "230111", PostalCode: "150500"},
+					{Name: "阿城区", Code: "230112", PostalCode: "150300", Since: 2006},
+					{Name: "双城区", Code: "230113", PostalCode: "150100", Since: 2014},
+					{Name: "依兰县", Code: "230123", PostalCode: "154800"},
+					{Name: "方正县", Code: "230124", PostalCode: "150800"},
+					{Name: "宾县", Code: "230125", PostalCode: "150400"},
+					{Name: "巴彦县", Code: "230126", PostalCode: "151800"},
+					{Name: "木兰县", Code: "230127", PostalCode: "151900"},
+					{Name: "通河县", Code: "230128", PostalCode: "150900"},
+					{Name: "延寿县", Code: "230129", PostalCode: "150700"},
+					{Name: "尚志市", Code: "230183", PostalCode: "150600"},
+					{Name: "五常市", Code: "230184", PostalCode: "150200"},
+				},
+			},
+			{
+				Name: "齐齐哈尔市", Code: "230200", Population: 407, PhoneCode: "0452", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "龙沙区", Code: "230202", PostalCode: "161000"},
+					{Name: "建华区", Code: "230203", PostalCode: "161000"},
+					{Name: "铁锋区", Code: "230204", PostalCode: "161000"},
+					{Name: "昂昂溪区", Code: "230205", PostalCode: "161031"},
+					{Name: "富拉尔基区", Code: "230206", PostalCode: "161041"},
+					{Name: "碾子山区", Code: "230207", PostalCode: "161046"},
+					{Name: "梅里斯达斡尔族区", Code: "230208", PostalCode: "161021"},
+					{Name: "龙江县", Code: "230221", PostalCode: "161100"},
+					{Name: "依安县", Code: "230223", PostalCode: "161500"},
+					{Name: "泰来县", Code: "230224", PostalCode: "162400"},
+					{Name: "甘南县", Code: "230225", PostalCode: "162100"},
+					{Name: "富裕县", Code: "230227", PostalCode: "161200"},
+					{Name: "克山县", Code: "230229", PostalCode: "161600"},
+					{Name: "克东县", Code: "230230", PostalCode: "164800"},
+					{Name: "拜泉县", Code: "230231", PostalCode: "164700"},
+					{Name: "讷河市", Code: "230281", PostalCode: "161300"},
+				},
+			},
+			{
+				Name: "鸡西市", Code: "230300", Population: 150, PhoneCode: "0467", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "鸡冠区", Code: "230302", PostalCode: "158100"},
+					{Name: "恒山区", Code: "230303", PostalCode: "158100"},
+					{Name: "滴道区", Code: "230304", PostalCode: "158100"},
+					{Name: "梨树区", Code: "230305", PostalCode: "158100"},
+					{Name: "城子河区", Code: "230306", PostalCode: "158100"},
+					{Name: "麻山区", Code: "230307", PostalCode: "158100"},
+					{Name: "鸡东县", Code: "230321", PostalCode: "158200"},
+					{Name: "虎林市", Code: "230381", PostalCode: "158400"},
+					{Name: "密山市", Code: "230382", PostalCode: "158300"},
+				},
+			},
+			{
+				Name: "鹤岗市", Code: "230400", Population: 89, PhoneCode: "0468", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "向阳区", Code: "230402", PostalCode: "154100"},
+					{Name: "工农区", Code: "230403", PostalCode: "154100"},
+					{Name: "南山区", Code: "230404", PostalCode: "154100"},
+					{Name: "兴安区", Code: "230405", PostalCode: "154100"},
+					{Name: "东山区", Code: "230406", PostalCode: "154100"},
+					{Name: "兴山区", Code: "230407", PostalCode: "154100"},
+					{Name: "萝北县", Code: "230421", PostalCode: "154200"},
+					{Name: "绥滨县", Code: "230422", PostalCode: "156200"},
+				},
+			},
+			{
+				Name: "双鸭山市", Code: "230500", Population: 121, PhoneCode: "0469", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "尖山区", Code: "230502", PostalCode: "155100"},
+					{Name: "岭东区", Code: "230503", PostalCode: "155100"},
+					{Name: "四方台区", Code: "230505", PostalCode: "155100"},
+					{Name: "宝山区", Code: "230506", PostalCode: "155100"},
+					{Name: "集贤县", Code: "230521", PostalCode: "155900"},
+					{Name: "友谊县", Code: "230522", PostalCode: "155800"},
+					{Name: "宝清县", Code: "230523", PostalCode: "155600"},
+					{Name: "饶河县", Code: "230524", PostalCode: "155700"},
+				},
+			},
+			{
+				Name: "大庆市", Code: "230600", Population: 278, PhoneCode: "0459", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "萨尔图区", Code: "230602", PostalCode: "163000"},
+					{Name: "龙凤区", Code: "230603", PostalCode: "163000"},
+					{Name: "让胡路区", Code: "230604", PostalCode: "163000"},
+					{Name: "红岗区", Code: "230605", PostalCode: "163000"},
+					{Name: "大同区", Code: "230606", PostalCode: "163500"},
+					{Name: "肇州县", Code: "230621", PostalCode: "166400"},
+					{Name: "肇源县", Code: "230622", PostalCode: "166500"},
+					{Name: "林甸县", Code: "230623", PostalCode: "166300"},
+					{Name: "杜尔伯特蒙古族自治县", Code: "230624", PostalCode: "166200"},
+				},
+			},
+			{
+				Name: "伊春市", Code: "230700", Population: 88, PhoneCode: "0458", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "伊美区", Code: "230717", PostalCode: "153000"},
+					{Name: "乌翠区", Code: "230718", PostalCode: "153000"},
+					{Name: "友好区", Code: "230719", PostalCode: "153000"},
+					{Name: "嘉荫县", Code: "230722", PostalCode: "153200"},
+					{Name: "汤旺县", Code: "230723", PostalCode: "153000"},
+					{Name: "丰林县", Code: "230724", PostalCode: "153000"},
+					{Name: "大箐山县", Code: "230725", PostalCode: "153000"},
+					{Name: "南岔县", Code: "230726", PostalCode: "153000"},
+					{Name: "金林区", Code: "230751", PostalCode: "153000"},
+					{Name: "铁力市", Code: "230781", PostalCode: "152500"},
+				},
+			},
+			{
+				Name: "佳木斯市", Code: "230800", Population: 216, PhoneCode: "0454", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "向阳区", Code: "230803", PostalCode: "154000"},
+					{Name: "前进区", Code: "230804", PostalCode: "154000"},
+					{Name: "东风区", Code: "230805", PostalCode: "154000"},
+					{Name: "郊区", Code: "230811", PostalCode: "154000"},
+					{Name: "桦南县", Code: "230822", PostalCode: "154400"},
+					{Name: "桦川县", Code: "230826", PostalCode: "154300"},
+					{Name: "汤原县", Code: "230828", PostalCode: "154700"},
+					{Name: "同江市", Code: "230881", PostalCode: "156400"},
+					{Name: "富锦市", Code: "230882", PostalCode: "156100"},
+					{Name: "抚远市", Code: "230883", PostalCode: "156500"},
+				},
+			},
+			{
+				Name: "七台河市", Code: "230900", Population: 69, PhoneCode: "0464", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新兴区", Code: "230902", PostalCode: "154600"},
+					{Name: "桃山区", Code: "230903", PostalCode: "154600"},
+					{Name: "茄子河区", Code: "230904", PostalCode: "154600"},
+					{Name: "勃利县", Code: "230921", PostalCode: "154500"},
+				},
+			},
+			{
+				Name: "牡丹江市", Code: "231000", Population: 229, PhoneCode: "0453", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东安区", Code: "231002", PostalCode: "157000"},
+					{Name: "阳明区", Code: "231003", PostalCode: "157000"},
+					{Name: "爱民区", Code: "231004", PostalCode: "157000"},
+					{Name: "西安区", Code: "231005", PostalCode: "157000"},
+					{Name: "林口县", Code: "231025", PostalCode: "157600"},
+					{Name: "绥芬河市", Code: "231081", PostalCode: "157300"},
+					{Name: "海林市", Code: "231083", PostalCode: "157100"},
+					{Name: "宁安市", Code: "231084", PostalCode: "157400"},
+					{Name: "穆棱市", Code: "231085", PostalCode: "157500"},
+					{Name: "东宁市", Code: "231086", PostalCode: "157200"},
+				},
+			},
+			{
+				Name: "黑河市", Code: "231100", Population: 129, PhoneCode: "0456", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "爱辉区", Code: "231102", PostalCode: "164300"},
+					{Name: "逊克县", Code: "231123", PostalCode: "164400"},
+					{Name: "孙吴县", Code: "231124", PostalCode: "164200"},
+					{Name: "北安市", Code: "231181", PostalCode: "164000"},
+					{Name: "五大连池市", Code: "231182", PostalCode: "164100"},
+					{Name: "嫩江市", Code: "231183", PostalCode: "161400"},
+				},
+			},
+			{
+				Name: "绥化市", Code: "231200", Population: 376, PhoneCode: "0455", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "北林区", Code: "231202", PostalCode: "152000"},
+					{Name: "望奎县", Code: "231221", PostalCode: "152100"},
+					{Name: "兰西县", Code: "231222", PostalCode: "151500"},
+					{Name: "青冈县", Code: "231223", PostalCode: "151600"},
+					{Name: "庆安县", Code: "231224", PostalCode: "152400"},
+					{Name: "明水县", Code: "231225", PostalCode: "151700"},
+					{Name: "绥棱县", Code: "231226", PostalCode: "152200"},
+					{Name: "安达市", Code: "231281", PostalCode: "151400"},
+					{Name: "肇东市", Code: "231282", PostalCode: "151100"},
+					{Name: "海伦市", Code: "231283", PostalCode: "152300"},
+				},
+			},
+			{
+				Name: "大兴安岭地区", Code: "232700", Population: 33, PhoneCode: "0457", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "漠河市", Code: "232701", PostalCode: "165300"},
+					{Name: "呼玛县", Code: "232721", PostalCode: "165100"},
+					{Name: "塔河县", Code: "232722", PostalCode: "165200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "上海市", Short: "上海", Code: "31",
+		Name: "上海市", Short: "上海", Abbr: "沪", Code: "31", Population: 2487,
 		Cities: []City{
-			{Name: "黄浦区", AreaCodes: []string{"310101"}},
-			{Name: "徐汇区", AreaCodes: []string{"310104"}},
-			{Name: "长宁区", AreaCodes: []string{"310105"}},
-			{Name: "静安区", AreaCodes: []string{"310106"}},
-			{Name: "普陀区", AreaCodes: []string{"310107"}},
-			{Name: "虹口区", AreaCodes: []string{"310109"}},
-			{Name: "杨浦区", AreaCodes: []string{"310110"}},
-			{Name: "浦东新区", AreaCodes: []string{"310115"}},
-			{Name: "闵行区", AreaCodes: []string{"310112"}},
-			{Name: "宝山区", AreaCodes: []string{"310113"}},
+			{
+				Name: "上海市", Code: "310100", Population: 2487, PhoneCode: "021", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "黄浦区", Code: "310101", PostalCode: "200001"},
+					{Name: "徐汇区", Code: "310104", PostalCode: "200030"},
+					{Name: "长宁区", Code: "310105", PostalCode: "200050"},
+					{Name: "静安区", Code: "310106", PostalCode: "200040"},
+					{Name: "普陀区", Code: "310107", PostalCode: "200333"},
+					{Name: "虹口区", Code: "310109", PostalCode: "200080"},
+					{Name: "杨浦区", Code: "310110", PostalCode: "200082"},
+					{Name: "闵行区", Code: "310112", PostalCode: "201100"},
+					{Name: "宝山区", Code: "310113", PostalCode: "201900", Since: 1988},
+					{Name: "嘉定区", Code: "310114", PostalCode: "201800", Since: 1992},
+					{Name: "浦东新区", Code: "310115", PostalCode: "200120", Since: 1993},
+					{Name: "金山区", Code: "310116", PostalCode: "201500", Since: 1997},
+					{Name: "松江区", Code: "310117", PostalCode: "201600", Since: 1998},
+					{Name: "青浦区", Code: "310118", PostalCode: "201700", Since: 1999},
+					{Name: "奉贤区", Code: "310120", PostalCode: "201400", Since: 2001},
+					{Name: "崇明区", Code: "310151", PostalCode: "202150", Since: 2016},
+				},
+			},
 		},
 	},
 	{
-		Name: "江苏省", Short: "江苏", Code: "32",
+		Name: "江苏省", Short: "江苏", Abbr: "苏", Code: "32", Population: 8475,
 		Cities: []City{
-			{Name: "南京市", AreaCodes: []string{"320100", "320102"}},
-			{Name: "无锡市", AreaCodes: []string{"320200"}},
-			{Name: "徐州市", AreaCodes: []string{"320300"}},
-			{Name: "常州市", AreaCodes: []string{"320400"}},
-			{Name: "苏州市", AreaCodes: []string{"320500", "320505"}},
-			{Name: "南通市", AreaCodes: []string{"320600"}},
-			{Name: "扬州市", AreaCodes: []string{"321000"}},
+			{
+				Name: "南京市", Code: "320100", Population: 931, PhoneCode: "025", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "玄武区", Code: "320102", PostalCode: "210018"},
+					{Name: "秦淮区", Code: "320104", PostalCode: "210001"},
+					{Name: "建邺区", Code: "320105", PostalCode: "210004"},
+					{Name: "鼓楼区", Code: "320106", PostalCode: "210009"},
+					{Name: "浦口区", Code: "320111", PostalCode: "211800"},
+					{Name: "栖霞区", Code: "320113", PostalCode: "210046"},
+					{Name: "雨花台区", Code: "320114", PostalCode: "210012"},
+					{Name: "江宁区", Code: "320115", PostalCode: "211100"},
+					{Name: "六合区", Code: "320116", PostalCode: "211500"},
+					{Name: "溧水区", Code: "320117", PostalCode: "211200", Since: 2013},
+					{Name: "高淳区", Code: "320118", PostalCode: "211300", Since: 2013},
+				},
+			},
+			{
+				Name: "无锡市", Code: "320200", Population: 746, PhoneCode: "0510", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "锡山区", Code: "320205", PostalCode: "214101"},
+					{Name: "惠山区", Code: "320206", PostalCode: "214174"},
+					{Name: "滨湖区", Code: "320211", PostalCode: "214071"},
+					{Name: "梁溪区", Code: "320213", PostalCode: "214000", Since: 2015},
+					{Name: "新吴区", Code: "320214", PostalCode: "214028", Since: 2015},
+					{Name: "江阴市", Code: "320281", PostalCode: "214400"},
+					{Name: "宜兴市", Code: "320282", PostalCode: "214200"},
+				},
+			},
+			{
+				Name: "徐州市", Code: "320300", Population: 908, PhoneCode: "0516", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "鼓楼区", Code: "320302", PostalCode: "221000"},
+					{Name: "云龙区", Code: "320303", PostalCode: "221000"},
+					{Name: "贾汪区", Code: "320305", PostalCode: "221011"},
+					{Name: "泉山区", Code: "320311", PostalCode: "221000"},
+					{Name: "铜山区", Code: "320312", PostalCode: "221100"},
+					{Name: "丰县", Code: "320321", PostalCode: "221700"},
+					{Name: "沛县", Code: "320322", PostalCode: "221600"},
+					{Name: "睢宁县", Code: "320324", PostalCode: "221200"},
+					{Name: "新沂市", Code: "320381", PostalCode: "221400"},
+					{Name: "邳州市", Code: "320382", PostalCode: "221300"},
+				},
+			},
+			{
+				Name: "常州市", Code: "320400", Population: 528, PhoneCode: "0519", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "天宁区", Code: "320402", PostalCode: "213000"},
+					{Name: "钟楼区", Code: "320404", PostalCode: "213000"},
+					{Name: "新北区", Code: "320411", PostalCode: "213000"},
+					{Name: "武进区", Code: "320412", PostalCode: "213100"},
+					{Name: "金坛区", Code: "320413", PostalCode: "213200", Since: 2015},
+					{Name: "溧阳市", Code: "320481", PostalCode: "213300"},
+				},
+			},
+			{
+				Name: "苏州市", Code: "320500", Population: 1275, PhoneCode: "0512", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "虎丘区", Code: "320505", PostalCode: "215004"},
+					{Name: "吴中区", Code: "320506", PostalCode: "215100"},
+					{Name: "相城区", Code: "320507", PostalCode: "215131"},
+					{Name: "姑苏区", Code: "320508", PostalCode: "215000", Since: 2012},
+					{Name: "吴江区", Code: "320509", PostalCode: "215200", Since: 2012},
+					{Name: "常熟市", Code: "320581", PostalCode: "215500"},
+					{Name: "张家港市", Code: "320582", PostalCode: "215600"},
+					{Name: "昆山市", Code: "320583", PostalCode: "215300"},
+					{Name: "太仓市", Code: "320585", PostalCode: "215400"},
+				},
+			},
+			{
+				Name: "南通市", Code: "320600", Population: 773, PhoneCode: "0513", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "通州区", Code: "320612", PostalCode: "226300"},
+					{Name: "崇川区", Code: "320613", PostalCode: "226000", Since: 2020},
+					{Name: "海门区", Code: "320614", PostalCode: "226100", Since: 2020},
+					{Name: "如东县", Code: "320623", PostalCode: "226400"},
+					{Name: "启东市", Code: "320681", PostalCode: "226200"},
+					{Name: "如皋市", Code: "320682", PostalCode: "226500"},
+					{Name: "海安市", Code: "320685", PostalCode: "226600", Since: 2018},
+				},
+			},
+			{
+				Name: "连云港市", Code: "320700", Population: 460, PhoneCode: "0518", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "连云区", Code: "320703", PostalCode: "222042"},
+					{Name: "海州区", Code: "320706", PostalCode: "222003"},
+					{Name: "赣榆区", Code: "320707", PostalCode: "222100"},
+					{Name: "东海县", Code: "320722", PostalCode: "222300"},
+					{Name: "灌云县", Code: "320723", PostalCode: "222200"},
+					{Name: "灌南县", Code: "320724", PostalCode: "223500"},
+				},
+			},
+			{
+				Name: "淮安市", Code: "320800", Population: 456, PhoneCode: "0517", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "淮安区", Code: "320803", PostalCode: "223200"},
+					{Name: "淮阴区", Code: "320804", PostalCode: "223300"},
+					{Name: "清江浦区", Code: "320812", PostalCode: "223001", Since: 2016},
+					{Name: "洪泽区", Code: "320813", PostalCode: "223100", Since: 2016},
+					{Name: "涟水县", Code: "320826", PostalCode: "223400"},
+					{Name: "盱眙县", Code: "320830", PostalCode: "211700"},
+					{Name: "金湖县", Code: "320831", PostalCode: "211600"},
+				},
+			},
+			{
+				Name: "盐城市", Code: "320900", Population: 671, PhoneCode: "0515", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "亭湖区", Code: "320902", PostalCode: "224005"},
+					{Name: "盐都区", Code: "320903", PostalCode: "224055"},
+					{Name: "大丰区", Code: "320904", PostalCode: "224100"},
+					{Name: "响水县", Code: "320921", PostalCode: "224600"},
+					{Name: "滨海县", Code: "320922", PostalCode: "224500"},
+					{Name: "阜宁县", Code: "320923", PostalCode: "224400"},
+					{Name: "射阳县", Code: "320924", PostalCode: "224300"},
+					{Name: "建湖县", Code: "320925", PostalCode: "224700"},
+					{Name: "东台市", Code: "320981", PostalCode: "224200"},
+				},
+			},
+			{
+				Name: "扬州市", Code: "321000", Population: 456, PhoneCode: "0514", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "广陵区", Code: "321002", PostalCode: "225002"},
+					{Name: "邗江区", Code: "321003", PostalCode: "225009"},
+					{Name: "江都区", Code: "321012", PostalCode: "225200", Since: 2011},
+					{Name: "宝应县", Code: "321023", PostalCode: "225800"},
+					{Name: "仪征市", Code: "321081", PostalCode: "211400"},
+					{Name: "高邮市", Code: "321084", PostalCode: "225600"},
+				},
+			},
+			{
+				Name: "镇江市", Code: "321100", Population: 321, PhoneCode: "0511", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "京口区", Code: "321102", PostalCode: "212001"},
+					{Name: "润州区", Code: "321111", PostalCode: "212004"},
+					{Name: "丹徒区", Code: "321112", PostalCode: "212028"},
+					{Name: "丹阳市", Code: "321181", PostalCode: "212300"},
+					{Name: "扬中市", Code: "321182", PostalCode: "212200"},
+					{Name: "句容市", Code: "321183", PostalCode: "212400"},
+				},
+			},
+			{
+				Name: "泰州市", Code: "321200", Population: 451, PhoneCode: "0523", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海陵区", Code: "321202", PostalCode: "225300"},
+					{Name: "高港区", Code: "321203", PostalCode: "225321"},
+					{Name: "姜堰区", Code: "321204", PostalCode: "225500"},
+					{Name: "兴化市", Code: "321281", PostalCode: "225700"},
+					{Name: "靖江市", Code: "321282", PostalCode: "214500"},
+					{Name: "泰兴市", Code: "321283", PostalCode: "225400"},
+				},
+			},
+			{
+				Name: "宿迁市", Code: "321300", Population: 499, PhoneCode: "0527", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "宿城区", Code: "321302", PostalCode: "223800"},
+					{Name: "宿豫区", Code: "321311", PostalCode: "223800"},
+					{Name: "沭阳县", Code: "321322", PostalCode: "223600"},
+					{Name: "泗阳县", Code: "321323", PostalCode: "223700"},
+					{Name: "泗洪县", Code: "321324", PostalCode: "223900"},
+				},
+			},
 		},
 	},
 	{
-		Name: "浙江省", Short: "浙江", Code: "33",
+		Name: "浙江省", Short: "浙江", Abbr: "浙", Code: "33", Population: 6457,
 		Cities: []City{
-			{Name: "杭州市", AreaCodes: []string{"330100", "330102"}},
-			{Name: "宁波市", AreaCodes: []string{"330200"}},
-			{Name: "温州市", AreaCodes: []string{"330300"}},
-			{Name: "嘉兴市", AreaCodes: []string{"330400"}},
-			{Name: "湖州市", AreaCodes: []string{"330500"}},
-			{Name: "绍兴市", AreaCodes: []string{"330600"}},
-			{Name: "金华市", AreaCodes: []string{"330700"}},
+			{
+				Name: "杭州市", Code: "330100", Population: 1194, PhoneCode: "0571", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "上城区", Code: "330102", PostalCode: "310002"},
+					{Name: "拱墅区", Code: "330105", PostalCode: "310011"},
+					{Name: "西湖区", Code: "330106", PostalCode: "310013"},
+					{Name: "滨江区", Code: "330108", PostalCode: "310051"},
+					{Name: "萧山区", Code: "330109", PostalCode: "311200", Since: 2001},
+					{Name: "余杭区", Code: "330110", PostalCode: "311100", Since: 2001},
+					{Name: "富阳区", Code: "330111", PostalCode: "311400", Since: 2014},
+					{Name: "临安区", Code: "330112", PostalCode: "311300", Since: 2017},
+					{Name: "临平区", Code: "330113", PostalCode: "311100", Since: 2021},
+					{Name: "钱塘区", Code: "330114", PostalCode: "310018", Since: 2021},
+					{Name: "桐庐县", Code: "330122", PostalCode: "311500"},
+					{Name: "淳安县", Code: "330127", PostalCode: "311700"},
+					{Name: "建德市", Code: "330182", PostalCode: "311600", Since: 1992},
+				},
+			},
+			{
+				Name: "宁波市", Code: "330200", Population: 940, PhoneCode: "0574", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "海曙区", Code: "330203", PostalCode: "315000"},
+					{Name: "江北区", Code: "330205", PostalCode: "315020"},
+					{Name: "北仑区", Code: "330206", PostalCode: "315800"},
+					{Name: "镇海区", Code: "330211", PostalCode: "315200"},
+					{Name: "鄞州区", Code: "330212", PostalCode: "315100", Since: 2002},
+					{Name: "奉化区", Code: "330213", PostalCode: "315500", Since: 2016},
+					{Name: "象山县", Code: "330225", PostalCode: "315700"},
+					{Name: "宁海县", Code: "330226", PostalCode: "315600"},
+					{Name: "余姚市", Code: "330281", PostalCode: "315400"},
+					{Name: "慈溪市", Code: "330282", PostalCode: "315300"},
+				},
+			},
+			{
+				Name: "温州市", Code: "330300", Population: 957, PhoneCode: "0577", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "鹿城区", Code: "330302", PostalCode: "325000"},
+					{Name: "龙湾区", Code: "330303", PostalCode: "325024"},
+					{Name: "瓯海区", Code: "330304", PostalCode: "325005"},
+					{Name: "洞头区", Code: "330305", PostalCode: "325700", Since: 2015},
+					{Name: "永嘉县", Code: "330324", PostalCode: "325100"},
+					{Name: "平阳县", Code: "330326", PostalCode: "325400"},
+					{Name: "苍南县", Code: "330327", PostalCode: "325800"},
+					{Name: "文成县", Code: "330328", PostalCode: "325300"},
+					{Name: "泰顺县", Code: "330329", PostalCode: "325500"},
+					{Name: "瑞安市", Code: "330381", PostalCode: "325200"},
+					{Name: "乐清市", Code: "330382", PostalCode: "325600"},
+					{Name: "龙港市", Code: "330383", PostalCode: "325802", Since: 2019},
+				},
+			},
+			{
+				Name: "嘉兴市", Code: "330400", Population: 540, PhoneCode: "0573", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "南湖区", Code: "330402", PostalCode: "314051"},
+					{Name: "秀洲区", Code: "330411", PostalCode: "314031"},
+					{Name: "嘉善县", Code: "330421", PostalCode: "314100"},
+					{Name: "海盐县", Code: "330424", PostalCode: "314300"},
+					{Name: "海宁市", Code: "330481", PostalCode: "314400"},
+					{Name: "平湖市", Code: "330482", PostalCode: "314200"},
+					{Name: "桐乡市", Code: "330483", PostalCode: "314500"},
+				},
+			},
+			{
+				Name: "湖州市", Code: "330500", Population: 337, PhoneCode: "0572", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "吴兴区", Code: "330502", PostalCode: "313000"},
+					{Name: "南浔区", Code: "330503", PostalCode: "313009"},
+					{Name: "德清县", Code: "330521", PostalCode: "313200"},
+					{Name: "长兴县", Code: "330522", PostalCode: "313100"},
+					{Name: "安吉县", Code: "330523", PostalCode: "313300"},
+				},
+			},
+			{
+				Name: "绍兴市", Code: "330600", Population: 527, PhoneCode: "0575", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "越城区", Code: "330602", PostalCode: "312000"},
+					{Name: "柯桥区", Code: "330603", PostalCode: "312030", Since: 2013},
+					{Name: "上虞区", Code: "330604", PostalCode: "312300", Since: 2013},
+					{Name: "新昌县", Code: "330624", PostalCode: "312500"},
+					{Name: "诸暨市", Code: "330681", PostalCode: "311800"},
+					{Name: "嵊州市", Code: "330683", PostalCode: "312400"},
+				},
+			},
+			{
+				Name: "金华市", Code: "330700", Population: 705, PhoneCode: "0579", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "婺城区", Code: "330702", PostalCode: "321000"},
+					{Name: "金东区", Code: "330703", PostalCode: "321000"},
+					{Name: "武义县", Code: "330723", PostalCode: "321200"},
+					{Name: "浦江县", Code: "330726", PostalCode: "322200"},
+					{Name: "磐安县", Code: "330727", PostalCode: "322300"},
+					{Name: "兰溪市", Code: "330781", PostalCode: "321100"},
+					{Name: "义乌市", Code: "330782", PostalCode: "322000"},
+					{Name: "东阳市", Code: "330783", PostalCode: "322100"},
+					{Name: "永康市", Code: "330784", PostalCode: "321300"},
+				},
+			},
+			{
+				Name: "衢州市", Code: "330800", Population: 228, PhoneCode: "0570", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "柯城区", Code: "330802", PostalCode: "324000"},
+					{Name: "衢江区", Code: "330803", PostalCode: "324022"},
+					{Name: "常山县", Code: "330822", PostalCode: "324200"},
+					{Name: "开化县", Code: "330824", PostalCode: "324300"},
+					{Name: "龙游县", Code: "330825", PostalCode: "324400"},
+					{Name: "江山市", Code: "330881", PostalCode: "324100"},
+				},
+			},
+			{
+				Name: "舟山市", Code: "330900", Population: 116, PhoneCode: "0580", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "定海区", Code: "330902", PostalCode: "316000"},
+					{Name: "普陀区", Code: "330903", PostalCode: "316100"},
+					{Name: "岱山县", Code: "330921", PostalCode: "316200"},
+					{Name: "嵊泗县", Code: "330922", PostalCode: "202450"},
+				},
+			},
+			{
+				Name: "台州市", Code: "331000", Population: 662, PhoneCode: "0576", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "椒江区", Code: "331002", PostalCode: "318000"},
+					{Name: "黄岩区", Code: "331003", PostalCode: "318020"},
+					{Name: "路桥区", Code: "331004", PostalCode: "318050"},
+					{Name: "三门县", Code: "331022", PostalCode: "317100"},
+					{Name: "天台县", Code: "331023", PostalCode: "317200"},
+					{Name: "仙居县", Code: "331024", PostalCode: "317300"},
+					{Name: "温岭市", Code: "331081", PostalCode: "317500"},
+					{Name: "临海市", Code: "331082", PostalCode: "317000"},
+					{Name: "玉环市", Code: "331083", PostalCode: "317600"},
+				},
+			},
+			{
+				Name: "丽水市", Code: "331100", Population: 251, PhoneCode: "0578", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "莲都区", Code: "331102", PostalCode: "323000"},
+					{Name: "青田县", Code: "331121", PostalCode: "323900"},
+					{Name: "缙云县", Code: "331122", PostalCode: "321400"},
+					{Name: "遂昌县", Code: "331123", PostalCode: "323300"},
+					{Name: "松阳县", Code: "331124", PostalCode: "323400"},
+					{Name: "云和县", Code: "331125", PostalCode: "323600"},
+					{Name: "庆元县", Code: "331126", PostalCode: "323800"},
+					{Name: "景宁畲族自治县", Code: "331127", PostalCode: "323500"},
+					{Name: "龙泉市", Code: "331181", PostalCode: "323700"},
+				},
+			},
 		},
 	},
 	{
-		Name: "安徽省", Short: "安徽", Code: "34",
+		Name: "安徽省", Short: "安徽", Abbr: "皖", Code: "34", Population: 6103,
 		Cities: []City{
-			{Name: "合肥市", AreaCodes: []string{"340100", "340102"}},
-			{Name: "芜湖市", AreaCodes: []string{"340200"}},
-			{Name: "蚌埠市", AreaCodes: []string{"340300"}},
-			{Name: "淮南市", AreaCodes: []string{"340400"}},
-			{Name: "马鞍山市", AreaCodes: []string{"340500"}},
-			{Name: "安庆市", AreaCodes: []string{"340800"}},
+			{
+				Name: "合肥市", Code: "340100", Population: 937, PhoneCode: "0551", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "瑶海区", Code: "340102", PostalCode: "230000"},
+					{Name: "庐阳区", Code: "340103", PostalCode: "230000"},
+					{Name: "蜀山区", Code: "340104", PostalCode: "230000"},
+					{Name: "包河区", Code: "340111", PostalCode: "230000"},
+					{Name: "长丰县", Code: "340121", PostalCode: "231100"},
+					{Name: "肥东县", Code: "340122", PostalCode: "231600"},
+					{Name: "肥西县", Code: "340123", PostalCode: "231200"},
+					{Name: "庐江县", Code: "340124", PostalCode: "231500"},
+					{Name: "巢湖市", Code: "340181", PostalCode: "238000", Since: 2011},
+				},
+			},
+			{
+				Name: "芜湖市", Code: "340200", Population: 364, PhoneCode: "0553", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "镜湖区", Code: "340202", PostalCode: "241000"},
+					{Name: "鸠江区", Code: "340207", PostalCode: "241000"},
+					{Name: "弋江区", Code: "340209", PostalCode: "241000"},
+					{Name: "湾沚区", Code: "340210", PostalCode: "241100", Since: 2020},
+					{Name: "繁昌区", Code: "340212", PostalCode: "241200", Since: 2020},
+					{Name: "南陵县", Code: "340223", PostalCode: "242400"},
+					{Name: "无为市", Code: "340281", PostalCode: "238300", Since: 2019},
+				},
+			},
+			{
+				Name: "蚌埠市", Code: "340300", Population: 330, PhoneCode: "0552", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "龙子湖区", Code: "340302", PostalCode: "233000"},
+					{Name: "蚌山区", Code: "340303", PostalCode: "233000"},
+					{Name: "禹会区", Code: "340304", PostalCode: "233000"},
+					{Name: "淮上区", Code: "340311", PostalCode: "233000"},
+					{Name: "怀远县", Code: "340321", PostalCode: "233400"},
+					{Name: "五河县", Code: "340322", PostalCode: "233300"},
+					{Name: "固镇县", Code: "340323", PostalCode: "233700"},
+				},
+			},
+			{
+				Name: "淮南市", Code: "340400", Population: 303, PhoneCode: "0554", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "大通区", Code: "340402", PostalCode: "232000"},
+					{Name: "田家庵区", Code: "340403", PostalCode: "232000"},
+					{Name: "谢家集区", Code: "340404", PostalCode: "232000"},
+					{Name: "八公山区", Code: "340405", PostalCode: "232000"},
+					{Name: "潘集区", Code: "340406", PostalCode: "232000"},
+					{Name: "凤台县", Code: "340421", PostalCode: "232100"},
+					{Name: "寿县", Code: "340422", PostalCode: "232200"},
+				},
+			},
+			{
+				Name: "马鞍山市", Code: "340500", Population: 216, PhoneCode: "0555", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "花山区", Code: "340503", PostalCode: "243000"},
+					{Name: "雨山区", Code: "340504", PostalCode: "243000"},
+					{Name: "博望区", Code: "340506", PostalCode: "243131"},
+					{Name: "当涂县", Code: "340521", PostalCode: "243100"},
+					{Name: "含山县", Code: "340522", PostalCode: "238100"},
+					{Name: "和县", Code: "340523", PostalCode: "238200"},
+				},
+			},
+			{
+				Name: "淮北市", Code: "340600", Population: 197, PhoneCode: "0561", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "杜集区", Code: "340602", PostalCode: "235000"},
+					{Name: "相山区", Code: "340603", PostalCode: "235000"},
+					{Name: "烈山区", Code: "340604", PostalCode: "235000"},
+					{Name: "濉溪县", Code: "340621", PostalCode: "235100"},
+				},
+			},
+			{
+				Name: "铜陵市", Code: "340700", Population: 131, PhoneCode: "0562", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "铜官区", Code: "340705", PostalCode: "244000"},
+					{Name: "义安区", Code: "340706", PostalCode: "244100"},
+					{Name: "郊区", Code: "340711", PostalCode: "244000"},
+					{Name: "枞阳县", Code: "340722", PostalCode: "246700"},
+				},
+			},
+			{
+				Name: "安庆市", Code: "340800", Population: 417, PhoneCode: "0556", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "迎江区", Code: "340802", PostalCode: "246000"},
+					{Name: "大观区", Code: "340803", PostalCode: "246000"},
+					{Name: "宜秀区", Code: "340811", PostalCode: "246000"},
+					{Name: "怀宁县", Code: "340822", PostalCode: "246100"},
+					{Name: "太湖县", Code: "340825", PostalCode: "246400"},
+					{Name: "宿松县", Code: "340826", PostalCode: "246500"},
+					{Name: "望江县", Code: "340827", PostalCode: "246200"},
+					{Name: "岳西县", Code: "340828", PostalCode: "246600"},
+					{Name: "桐城市", Code: "340881", PostalCode: "231400"},
+					{Name: "潜山市", Code: "340882", PostalCode: "246300"},
+				},
+			},
+			{
+				Name: "黄山市", Code: "341000", Population: 133, PhoneCode: "0559", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "屯溪区", Code: "341002", PostalCode: "245000"},
+					{Name: "黄山区", Code: "341003", PostalCode: "245700"},
+					{Name: "徽州区", Code: "341004", PostalCode: "245061"},
+					{Name: "歙县", Code: "341021", PostalCode: "245200"},
+					{Name: "休宁县", Code: "341022", PostalCode: "245400"},
+					{Name: "黟县", Code: "341023", PostalCode: "245500"},
+					{Name: "祁门县", Code: "341024", PostalCode: "245600"},
+				},
+			},
+			{
+				Name: "滁州市", Code: "341100", Population: 399, PhoneCode: "0550", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "琅琊区", Code: "341102", PostalCode: "239000"},
+					{Name: "南谯区", Code: "341103", PostalCode: "239000"},
+					{Name: "来安县", Code: "341122", PostalCode: "239200"},
+					{Name: "全椒县", Code: "341124", PostalCode: "239500"},
+					{Name: "定远县", Code: "341125", PostalCode: "233200"},
+					{Name: "凤阳县", Code: "341126", PostalCode: "233100"},
+					{Name: "天长市", Code: "341181", PostalCode: "239300"},
+					{Name: "明光市", Code: "341182", PostalCode: "239400"},
+				},
+			},
+			{
+				Name: "阜阳市", Code: "341200", Population: 820, PhoneCode: "0558", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "颍州区", Code: "341202", PostalCode: "236000"},
+					{Name: "颍东区", Code: "341203", PostalCode: "236000"},
+					{Name: "颍泉区", Code: "341204", PostalCode: "236000"},
+					{Name: "临泉县", Code: "341221", PostalCode: "236400"},
+					{Name: "太和县", Code: "341222", PostalCode: "236600"},
+					{Name: "阜南县", Code: "341225", PostalCode: "236300"},
+					{Name: "颍上县", Code: "341226", PostalCode: "236200"},
+					{Name: "界首市", Code: "341282", PostalCode: "236500"},
+				},
+			},
+			{
+				Name: "宿州市", Code: "341300", Population: 532, PhoneCode: "0557", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "埇桥区", Code: "341302", PostalCode: "234000"},
+					{Name: "砀山县", Code: "341321", PostalCode: "235300"},
+					{Name: "萧县", Code: "341322", PostalCode: "235200"},
+					{Name: "灵璧县", Code: "341323", PostalCode: "234200"},
+					{Name: "泗县", Code: "341324", PostalCode: "234300"},
+				},
+			},
+			{
+				Name: "六安市", Code: "341500", Population: 440, PhoneCode: "0564", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "金安区", Code: "341502", PostalCode: "237000"},
+					{Name: "裕安区", Code: "341503", PostalCode: "237000"},
+					{Name: "叶集区", Code: "341504", PostalCode: "237431"},
+					{Name: "霍邱县", Code: "341522", PostalCode: "237400"},
+					{Name: "舒城县", Code: "341523", PostalCode: "231300"},
+					{Name: "金寨县", Code: "341524", PostalCode: "237300"},
+					{Name: "霍山县", Code: "341525", PostalCode: "237200"},
+				},
+			},
+			{
+				Name: "亳州市", Code: "341600", Population: 500, PhoneCode: "0558", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "谯城区", Code: "341602", PostalCode: "236800"},
+					{Name: "涡阳县", Code: "341621", PostalCode: "233600"},
+					{Name: "蒙城县", Code: "341622", PostalCode: "233500"},
+					{Name: "利辛县", Code: "341623", PostalCode: "236700"},
+				},
+			},
+			{
+				Name: "池州市", Code: "341700", Population: 134, PhoneCode: "0566", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "贵池区", Code: "341702", PostalCode: "247000"},
+					{Name: "东至县", Code: "341721", PostalCode: "247200"},
+					{Name: "石台县", Code: "341722", PostalCode: "245100"},
+					{Name: "青阳县", Code: "341723", PostalCode: "242800"},
+				},
+			},
+			{
+				Name: "宣城市", Code: "341800", Population: 250, PhoneCode: "0563", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "宣州区", Code: "341802", PostalCode: "242000"},
+					{Name: "郎溪县", Code: "341821", PostalCode: "242100"},
+					{Name: "泾县", Code: "341823", PostalCode: "242500"},
+					{Name: "绩溪县", Code: "341824", PostalCode: "245300"},
+					{Name: "旌德县", Code: "341825", PostalCode: "242600"},
+					{Name: "宁国市", Code: "341881", PostalCode: "242300"},
+					{Name: "广德市", Code: "341882", PostalCode: "242200", Since: 2019},
+				},
+			},
 		},
 	},
 	{
-		Name: "福建省", Short: "福建", Code: "35",
+		Name: "福建省", Short: "福建", Abbr: "闽", Code: "35", Population: 4154,
 		Cities: []City{
-			{Name: "福州市", AreaCodes: []string{"350100", "350102"}},
-			{Name: "厦门市", AreaCodes: []string{"350200", "350203"}},
-			{Name: "莆田市", AreaCodes: []string{"350300"}},
-			{Name: "泉州市", AreaCodes: []string{"350500"}},
-			{Name: "漳州市", AreaCodes: []string{"350600"}},
+			{
+				Name: "福州市", Code: "350100", Population: 829, PhoneCode: "0591", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "鼓楼区", Code: "350102", PostalCode: "350001"},
+					{Name: "台江区", Code: "350103", PostalCode: "350004"},
+					{Name: "仓山区", Code: "350104", PostalCode: "350007"},
+					{Name: "马尾区", Code: "350105", PostalCode: "350015"},
+					{Name: "晋安区", Code: "350111", PostalCode: "350011"},
+					{Name: "长乐区", Code: "350112", PostalCode: "350200", Since: 2017},
+					{Name: "闽侯县", Code: "350121", PostalCode: "350100"},
+					{Name: "连江县", Code: "350122", PostalCode: "350500"},
+					{Name: "罗源县", Code: "350123", PostalCode: "350600"},
+					{Name: "闽清县", Code: "350124", PostalCode: "350800"},
+					{Name: "永泰县", Code: "350125", PostalCode: "350700"},
+					{Name: "平潭县", Code: "350128", PostalCode: "350400"},
+					{Name: "福清市", Code: "350181", PostalCode: "350300"},
+				},
+			},
+			{
+				Name: "厦门市", Code: "350200", Population: 516, PhoneCode: "0592", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "思明区", Code: "350203", PostalCode: "361001"},
+					{Name: "海沧区", Code: "350205", PostalCode: "361026"},
+					{Name: "湖里区", Code: "350206", PostalCode: "361006"},
+					{Name: "集美区", Code: "350211", PostalCode: "361021"},
+					{Name: "同安区", Code: "350212", PostalCode: "361100"},
+					{Name: "翔安区", Code: "350213", PostalCode: "361101"},
+				},
+			},
+			{
+				Name: "莆田市", Code: "350300", Population: 321, PhoneCode: "0594", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城厢区", Code: "350302", PostalCode: "351100"},
+					{Name: "涵江区", Code: "350303", PostalCode: "351111"},
+					{Name: "荔城区", Code: "350304", PostalCode: "351100"},
+					{Name: "秀屿区", Code: "350305", PostalCode: "351152"},
+					{Name: "仙游县", Code: "350322", PostalCode: "351200"},
+				},
+			},
+			{
+				Name: "三明市", Code: "350400", Population: 249, PhoneCode: "0598", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "三元区", Code: "350404", PostalCode: "365000", Since: 2021},
+					{Name: "沙县区", Code: "350405", PostalCode: "365500", Since: 2021},
+					{Name: "明溪县", Code: "350421", PostalCode: "365200"},
+					{Name: "清流县", Code: "350423", PostalCode: "365300"},
+					{Name: "宁化县", Code: "350424", PostalCode: "365400"},
+					{Name: "大田县", Code: "350425", PostalCode: "366100"},
+					{Name: "尤溪县", Code: "350426", PostalCode: "365100"},
+					{Name: "将乐县", Code: "350428", PostalCode: "353300"},
+					{Name: "泰宁县", Code: "350429", PostalCode: "354400"},
+					{Name: "建宁县", Code: "350430", PostalCode: "354500"},
+					{Name: "永安市", Code: "350481", PostalCode: "366000"},
+				},
+			},
+			{
+				Name: "泉州市", Code: "350500", Population: 878, PhoneCode: "0595", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "鲤城区", Code: "350502", PostalCode: "362000"},
+					{Name: "丰泽区", Code: "350503", PostalCode: "362000"},
+					{Name: "洛江区", Code: "350504", PostalCode: "362011"},
+					{Name: "泉港区", Code: "350505", PostalCode: "362800"},
+					{Name: "惠安县", Code: "350521", PostalCode: "362100"},
+					{Name: "安溪县", Code: "350524", PostalCode: "362400"},
+					{Name: "永春县", Code: "350525", PostalCode: "362600"},
+					{Name: "德化县", Code: "350526", PostalCode: "362500"},
+					{Name: "金门县", Code: "350527", PostalCode: "362000"},
+					{Name: "石狮市", Code: "350581", PostalCode: "362700"},
+					{Name: "晋江市", Code: "350582", PostalCode: "362200"},
+					{Name: "南安市", Code: "350583", PostalCode: "362300"},
+				},
+			},
+			{
+				Name: "漳州市", Code: "350600", Population: 505, PhoneCode: "0596", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "芗城区", Code: "350602", PostalCode: "363000"},
+					{Name: "龙文区", Code: "350603", PostalCode: "363005"},
+					{Name: "龙海区", Code: "350604", PostalCode: "363100", Since: 2021},
+					{Name: "长泰区", Code: "350605", PostalCode: "363900", Since: 2021},
+					{Name: "云霄县", Code: "350622", PostalCode: "363300"},
+					{Name: "漳浦县", Code: "350623", PostalCode: "363200"},
+					{Name: "诏安县", Code: "350624", PostalCode: "363500"},
+					{Name: "东山县", Code: "350626", PostalCode: "363400"},
+					{Name: "南靖县", Code: "350627", PostalCode: "363600"},
+					{Name: "平和县", Code: "350628", PostalCode: "363700"},
+					{Name: "华安县", Code: "350629", PostalCode: "363800"},
+				},
+			},
+			{
+				Name: "南平市", Code: "350700", Population: 268, PhoneCode: "0599", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "延平区", Code: "350702", PostalCode: "353000"},
+					{Name: "建阳区", Code: "350703", PostalCode: "354200"},
+					{Name: "顺昌县", Code: "350721", PostalCode: "353200"},
+					{Name: "浦城县", Code: "350722", PostalCode: "353400"},
+					{Name: "光泽县", Code: "350723", PostalCode: "354100"},
+					{Name: "松溪县", Code: "350724", PostalCode: "353500"},
+					{Name: "政和县", Code: "350725", PostalCode: "353600"},
+					{Name: "邵武市", Code: "350781", PostalCode: "354000"},
+					{Name: "武夷山市", Code: "350782", PostalCode: "354300"},
+					{Name: "建瓯市", Code: "350783", PostalCode: "353100"},
+				},
+			},
+			{
+				Name: "龙岩市", Code: "350800", Population: 272, PhoneCode: "0597", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新罗区", Code: "350802", PostalCode: "364000"},
+					{Name: "永定区", Code: "350803", PostalCode: "364100"},
+					{Name: "长汀县", Code: "350821", PostalCode: "366300"},
+					{Name: "上杭县", Code: "350823", PostalCode: "364200"},
+					{Name: "武平县", Code: "350824", PostalCode: "364300"},
+					{Name: "连城县", Code: "350825", PostalCode: "366200"},
+					{Name: "漳平市", Code: "350881", PostalCode: "364400"},
+				},
+			},
+			{
+				Name: "宁德市", Code: "350900", Population: 315, PhoneCode: "0593", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "蕉城区", Code: "350902", PostalCode: "352100"},
+					{Name: "霞浦县", Code: "350921", PostalCode: "355100"},
+					{Name: "古田县", Code: "350922", PostalCode: "352200"},
+					{Name: "屏南县", Code: "350923", PostalCode: "352300"},
+					{Name: "寿宁县", Code: "350924", PostalCode: "355500"},
+					{Name: "周宁县", Code: "350925", PostalCode: "355400"},
+					{Name: "柘荣县", Code: "350926", PostalCode: "355300"},
+					{Name: "福安市", Code: "350981", PostalCode: "355000"},
+					{Name: "福鼎市", Code: "350982", PostalCode: "355200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "江西省", Short: "江西", Code: "36",
+		Name: "江西省", Short: "江西", Abbr: "赣", Code: "36", Population: 4519,
 		Cities: []City{
-			{Name: "南昌市", AreaCodes: []string{"360100", "360102"}},
-			{Name: "景德镇市", AreaCodes: []string{"360200"}},
-			{Name: "萍乡市", AreaCodes: []string{"360300"}},
-			{Name: "九江市", AreaCodes: []string{"360400"}},
-			{Name: "赣州市", AreaCodes: []string{"360700"}},
+			{
+				Name: "南昌市", Code: "360100", Population: 626, PhoneCode: "0791", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "东湖区", Code: "360102", PostalCode: "330000"},
+					{Name: "西湖区", Code: "360103", PostalCode: "330000"},
+					{Name: "青云谱区", Code: "360104", PostalCode: "330000"},
+					{Name: "青山湖区", Code: "360111", PostalCode: "330000"},
+					{Name: "新建区", Code: "360112", PostalCode: "330100", Since: 2015},
+					{Name: "红谷滩区", Code: "360113", PostalCode: "330000"},
+					{Name: "南昌县", Code: "360121", PostalCode: "330200"},
+					{Name: "安义县", Code: "360123", PostalCode: "330500"},
+					{Name: "进贤县", Code: "360124", PostalCode: "331700"},
+				},
+			},
+			{
+				Name: "景德镇市", Code: "360200", Population: 162, PhoneCode: "0798", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "昌江区", Code: "360202", PostalCode: "333000"},
+					{Name: "珠山区", Code: "360203", PostalCode: "333000"},
+					{Name: "浮梁县", Code: "360222", PostalCode: "333400"},
+					{Name: "乐平市", Code: "360281", PostalCode: "333300"},
+				},
+			},
+			{
+				Name: "萍乡市", Code: "360300", Population: 180, PhoneCode: "0799", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "安源区", Code: "360302", PostalCode: "337000"},
+					{Name: "湘东区", Code: "360313", PostalCode: "337016"},
+					{Name: "莲花县", Code: "360321", PostalCode: "337100"},
+					{Name: "上栗县", Code: "360322", PostalCode: "337009"},
+					{Name: "芦溪县", Code: "360323", PostalCode: "337053"},
+				},
+			},
+			{
+				Name: "九江市", Code: "360400", Population: 460, PhoneCode: "0792", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "濂溪区", Code: "360402", PostalCode: "332005"},
+					{Name: "浔阳区", Code: "360403", PostalCode: "332000"},
+					{Name: "柴桑区", Code: "360404", PostalCode: "332100", Since: 2017},
+					{Name: "武宁县", Code: "360423", PostalCode: "332300"},
+					{Name: "修水县", Code: "360424", PostalCode: "332400"},
+					{Name: "永修县", Code: "360425", PostalCode: "330300"},
+					{Name: "德安县", Code: "360426", PostalCode: "330400"},
+					{Name: "都昌县", Code: "360428", PostalCode: "332600"},
+					{Name: "湖口县", Code: "360429", PostalCode: "332500"},
+					{Name: "彭泽县", Code: "360430", PostalCode: "332700"},
+					{Name: "瑞昌市", Code: "360481", PostalCode: "332200"},
+					{Name: "共青城市", Code: "360482", PostalCode: "332020"},
+					{Name: "庐山市", Code: "360483", PostalCode: "332800", Since: 2016},
+				},
+			},
+			{
+				Name: "新余市", Code: "360500", Population: 120, PhoneCode: "0790", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "渝水区", Code: "360502", PostalCode: "338000"},
+					{Name: "分宜县", Code: "360521", PostalCode: "336600"},
+				},
+			},
+			{
+				Name: "鹰潭市", Code: "360600", Population: 115, PhoneCode: "0701", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "月湖区", Code: "360602", PostalCode: "335000"},
+					{Name: "余江区", Code: "360603", PostalCode: "335200", Since: 2018},
+					{Name: "贵溪市", Code: "360681", PostalCode: "335400"},
+				},
+			},
+			{
+				Name: "赣州市", Code: "360700", Population: 897, PhoneCode: "0797", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "章贡区", Code: "360702", PostalCode: "341000"},
+					{Name: "南康区", Code: "360703", PostalCode: "341400", Since: 2013},
+					{Name: "赣县区", Code: "360704", PostalCode: "341100", Since: 2016},
+					{Name: "信丰县", Code: "360722", PostalCode: "341600"},
+					{Name: "大余县", Code: "360723", PostalCode: "341500"},
+					{Name: "上犹县", Code: "360724", PostalCode: "341200"},
+					{Name: "崇义县", Code: "360725", PostalCode: "341300"},
+					{Name: "安远县", Code: "360726", PostalCode: "342100"},
+					{Name: "定南县", Code: "360728", PostalCode: "341900"},
+					{Name: "全南县", Code: "360729", PostalCode: "341800"},
+					{Name: "宁都县", Code: "360730", PostalCode: "342800"},
+					{Name: "于都县", Code: "360731", PostalCode: "342300"},
+					{Name: "兴国县", Code: "360732", PostalCode: "342400"},
+					{Name: "会昌县", Code: "360733", PostalCode: "342600"},
+					{Name: "寻乌县", Code: "360734", PostalCode: "342200"},
+					{Name: "石城县", Code: "360735", PostalCode: "342700"},
+					{Name: "瑞金市", Code: "360781", PostalCode: "342500"},
+					{Name: "龙南市", Code: "360783", PostalCode: "341700", Since: 2020},
+				},
+			},
+			{
+				Name: "吉安市", Code: "360800", Population: 446, PhoneCode: "0796", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "吉州区", Code: "360802", PostalCode: "343000"},
+					{Name: "青原区", Code: "360803", PostalCode: "343009"},
+					{Name: "吉安县", Code: "360821", PostalCode: "343100"},
+					{Name: "吉水县", Code: "360822", PostalCode: "331600"},
+					{Name: "峡江县", Code: "360823", PostalCode: "331400"},
+					{Name: "新干县", Code: "360824", PostalCode: "331300"},
+					{Name: "永丰县", Code: "360825", PostalCode: "331500"},
+					{Name: "泰和县", Code: "360826", PostalCode: "343700"},
+					{Name: "遂川县", Code: "360827", PostalCode: "343900"},
+					{Name: "万安县", Code: "360828", PostalCode: "343800"},
+					{Name: "安福县", Code: "360829", PostalCode: "343200"},
+					{Name: "永新县", Code: "360830", PostalCode: "343400"},
+					{Name: "井冈山市", Code: "360881", PostalCode: "343600"},
+				},
+			},
+			{
+				Name: "宜春市", Code: "360900", Population: 500, PhoneCode: "0795", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "袁州区", Code: "360902", PostalCode: "336000"},
+					{Name: "奉新县", Code: "360921", PostalCode: "330700"},
+					{Name: "万载县", Code: "360922", PostalCode: "336100"},
+					{Name: "上高县", Code: "360923", PostalCode: "336400"},
+					{Name: "宜丰县", Code: "360924", PostalCode: "336300"},
+					{Name: "靖安县", Code: "360925", PostalCode: "330600"},
+					{Name: "铜鼓县", Code: "360926", PostalCode: "336200"},
+					{Name: "丰城市", Code: "360981", PostalCode: "331100"},
+					{Name: "樟树市", Code: "360982", PostalCode: "331200"},
+					{Name: "高安市", Code: "360983", PostalCode: "330800"},
+				},
+			},
+			{
+				Name: "抚州市", Code: "361000", Population: 361, PhoneCode: "0794", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "临川区", Code: "361002", PostalCode: "344000"},
+					{Name: "东乡区", Code: "361003", PostalCode: "331800"},
+					{Name: "南城县", Code: "361021", PostalCode: "344700"},
+					{Name: "黎川县", Code: "361022", PostalCode: "344600"},
+					{Name: "南丰县", Code: "361023", PostalCode: "344500"},
+					{Name: "崇仁县", Code: "361024", PostalCode: "344200"},
+					{Name: "乐安县", Code: "361025", PostalCode: "344300"},
+					{Name: "宜黄县", Code: "361026", PostalCode: "344400"},
+					{Name: "金溪县", Code: "361027", PostalCode: "344800"},
+					{Name: "资溪县", Code: "361028", PostalCode: "335300"},
+					{Name: "广昌县", Code: "361030", PostalCode: "344900"},
+				},
+			},
+			{
+				Name: "上饶市", Code: "361100", Population: 649, PhoneCode: "0793", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "信州区", Code: "361102", PostalCode: "334000"},
+					{Name: "广丰区", Code: "361103", PostalCode: "334600", Since: 2015},
+					{Name: "广信区", Code: "361104", PostalCode: "334100", Since: 2019},
+					{Name: "玉山县", Code: "361123", PostalCode: "334700"},
+					{Name: "铅山县", Code: "361124", PostalCode: "334500"},
+					{Name: "横峰县", Code: "361125", PostalCode: "334300"},
+					{Name: "弋阳县", Code: "361126", PostalCode: "334400"},
+					{Name: "余干县", Code: "361127", PostalCode: "335100"},
+					{Name: "鄱阳县", Code: "361128", PostalCode: "333100"},
+					{Name: "万年县", Code: "361129", PostalCode: "335500"},
+					{Name: "婺源县", Code: "361130", PostalCode: "333200"},
+					{Name: "德兴市", Code: "361181", PostalCode: "334200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "山东省", Short: "山东", Code: "37",
+		Name: "山东省", Short: "山东", Abbr: "鲁", Code: "37", Population: 10153,
 		Cities: []City{
-			{Name: "济南市", AreaCodes: []string{"370100", "370102"}},
-			{Name: "青岛市", AreaCodes: []string{"370200", "370202"}},
-			{Name: "淄博市", AreaCodes: []string{"370300"}},
-			{Name: "枣庄市", AreaCodes: []string{"370400"}},
-			{Name: "东营市", AreaCodes: []string{"370500"}},
-			{Name: "烟台市", AreaCodes: []string{"370600"}},
-			{Name: "潍坊市", AreaCodes: []string{"370700"}},
-			{Name: "威海市", AreaCodes: []string{"371000"}},
+			{
+				Name: "济南市", Code: "370100", Population: 920, PhoneCode: "0531", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "历下区", Code: "370102", PostalCode: "250000"},
+					{Name: "市中区", Code: "370103", PostalCode: "250000"},
+					{Name: "槐荫区", Code: "370104", PostalCode: "250000"},
+					{Name: "天桥区", Code: "370105", PostalCode: "250000"},
+					{Name: "历城区", Code: "370112", PostalCode: "250000"},
+					{Name: "长清区", Code: "370113", PostalCode: "250300"},
+					{Name: "章丘区", Code: "370114", PostalCode: "250200", Since: 2016},
+					{Name: "济阳区", Code: "370115", PostalCode: "251400", Since: 2018},
+					{Name: "莱芜区", Code: "370116", PostalCode: "271100", Since: 2019},
+					{Name: "钢城区", Code: "370117", PostalCode: "271104", Since: 2019},
+					{Name: "平阴县", Code: "370124", PostalCode: "250400"},
+					{Name: "商河县", Code: "370126", PostalCode: "251600"},
+				},
+			},
+			{
+				Name: "青岛市", Code: "370200", Population: 1007, PhoneCode: "0532", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "市南区", Code: "370202", PostalCode: "266001"},
+					{Name: "市北区", Code: "370203", PostalCode: "266011"},
+					{Name: "黄岛区", Code: "370211", PostalCode: "266500"},
+					{Name: "崂山区", Code: "370212", PostalCode: "266100"},
+					{Name: "李沧区", Code: "370213", PostalCode: "266041"},
+					{Name: "城阳区", Code: "370214", PostalCode: "266109"},
+					{Name: "即墨区", Code: "370215", PostalCode: "266200", Since: 2017},
+					{Name: "胶州市", Code: "370281", PostalCode: "266300"},
+					{Name: "平度市", Code: "370283", PostalCode: "266700"},
+					{Name: "莱西市", Code: "370285", PostalCode: "266600"},
+				},
+			},
+			{
+				Name: "淄博市", Code: "370300", Population: 470, PhoneCode: "0533", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "淄川区", Code: "370302", PostalCode: "255100"},
+					{Name: "张店区", Code: "370303", PostalCode: "255000"},
+					{Name: "博山区", Code: "370304", PostalCode: "255200"},
+					{Name: "临淄区", Code: "370305", PostalCode: "255400"},
+					{Name: "周村区", Code: "370306", PostalCode: "255300"},
+					{Name: "桓台县", Code: "370321", PostalCode: "256400"},
+					{Name: "高青县", Code: "370322", PostalCode: "256300"},
+					{Name: "沂源县", Code: "370323", PostalCode: "256100"},
+				},
+			},
+			{
+				Name: "枣庄市", Code: "370400", Population: 386, PhoneCode: "0632", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "市中区", Code: "370402", PostalCode: "277100"},
+					{Name: "薛城区", Code: "370403", PostalCode: "277000"},
+					{Name: "峄城区", Code: "370404", PostalCode: "277300"},
+					{Name: "台儿庄区", Code: "370405", PostalCode: "277400"},
+					{Name: "山亭区", Code: "370406", PostalCode: "277200"},
+					{Name: "滕州市", Code: "370481", PostalCode: "277500"},
+				},
+			},
+			{
+				Name: "东营市", Code: "370500", Population: 219, PhoneCode: "0546", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东营区", Code: "370502", PostalCode: "257000"},
+					{Name: "河口区", Code: "370503", PostalCode: "257200"},
+					{Name: "垦利区", Code: "370505", PostalCode: "257500"},
+					{Name: "利津县", Code: "370522", PostalCode: "257400"},
+					{Name: "广饶县", Code: "370523", PostalCode: "257300"},
+				},
+			},
+			{
+				Name: "烟台市", Code: "370600", Population: 710, PhoneCode: "0535", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "芝罘区", Code: "370602", PostalCode: "264000"},
+					{Name: "福山区", Code: "370611", PostalCode: "265500"},
+					{Name: "牟平区", Code: "370612", PostalCode: "264100"},
+					{Name: "莱山区", Code: "370613", PostalCode: "264003"},
+					{Name: "蓬莱区", Code: "370614", PostalCode: "265600", Since: 2020},
+					{Name: "龙口市", Code: "370681", PostalCode: "265700"},
+					{Name: "莱阳市", Code: "370682", PostalCode: "265200"},
+					{Name: "莱州市", Code: "370683", PostalCode: "261400"},
+					{Name: "招远市", Code: "370685", PostalCode: "265400"},
+					{Name: "栖霞市", Code: "370686", PostalCode: "265300"},
+					{Name: "海阳市", Code: "370687", PostalCode: "265100"},
+				},
+			},
+			{
+				Name: "潍坊市", Code: "370700", Population: 939, PhoneCode: "0536", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "潍城区", Code: "370702", PostalCode: "261000"},
+					{Name: "寒亭区", Code: "370703", PostalCode: "261100"},
+					{Name: "坊子区", Code: "370704", PostalCode: "261200"},
+					{Name: "奎文区", Code: "370705", PostalCode: "261000"},
+					{Name: "临朐县", Code: "370724", PostalCode: "262600"},
+					{Name: "昌乐县", Code: "370725", PostalCode: "262400"},
+					{Name: "青州市", Code: "370781", PostalCode: "262500"},
+					{Name: "诸城市", Code: "370782", PostalCode: "262200"},
+					{Name: "寿光市", Code: "370783", PostalCode: "262700"},
+					{Name: "安丘市", Code: "370784", PostalCode: "262100"},
+					{Name: "高密市", Code: "370785", PostalCode: "261500"},
+					{Name: "昌邑市", Code: "370786", PostalCode: "261300"},
+				},
+			},
+			{
+				Name: "济宁市", Code: "370800", Population: 836, PhoneCode: "0537", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "任城区", Code: "370811", PostalCode: "272000"},
+					{Name: "兖州区", Code: "370812", PostalCode: "272000"},
+					{Name: "微山县", Code: "370826", PostalCode: "277600"},
+					{Name: "鱼台县", Code: "370827", PostalCode: "272300"},
+					{Name: "金乡县", Code: "370828", PostalCode: "272200"},
+					{Name: "嘉祥县", Code: "370829", PostalCode: "272400"},
+					{Name: "汶上县", Code: "370830", PostalCode: "272500"},
+					{Name: "泗水县", Code: "370831", PostalCode: "273200"},
+					{Name: "梁山县", Code: "370832", PostalCode: "272600"},
+					{Name: "曲阜市", Code: "370881", PostalCode: "273100"},
+					{Name: "邹城市", Code: "370883", PostalCode: "273500"},
+				},
+			},
+			{
+				Name: "泰安市", Code: "370900", Population: 547, PhoneCode: "0538", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "泰山区", Code: "370902", PostalCode: "271000"},
+					{Name: "岱岳区", Code: "370911", PostalCode: "271000"},
+					{Name: "宁阳县", Code: "370921", PostalCode: "271400"},
+					{Name: "东平县", Code: "370923", PostalCode: "271500"},
+					{Name: "新泰市", Code: "370982", PostalCode: "271200"},
+					{Name: "肥城市", Code: "370983", PostalCode: "271600"},
+				},
+			},
+			{
+				Name: "威海市", Code: "371000", Population: 291, PhoneCode: "0631", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "环翠区", Code: "371002", PostalCode: "264200"},
+					{Name: "文登区", Code: "371003", PostalCode: "264400"},
+					{Name: "荣成市", Code: "371082", PostalCode: "264300"},
+					{Name: "乳山市", Code: "371083", PostalCode: "264500"},
+				},
+			},
+			{
+				Name: "日照市", Code: "371100", Population: 297, PhoneCode: "0633", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东港区", Code: "371102", PostalCode: "276800"},
+					{Name: "岚山区", Code: "371103", PostalCode: "276800"},
+					{Name: "五莲县", Code: "371121", PostalCode: "262300"},
+					{Name: "莒县", Code: "371122", PostalCode: "276500"},
+				},
+			},
+			{
+				Name: "临沂市", Code: "371300", Population: 1102, PhoneCode: "0539", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "兰山区", Code: "371302", PostalCode: "276000"},
+					{Name: "罗庄区", Code: "371311", PostalCode: "276000"},
+					{Name: "河东区", Code: "371312", PostalCode: "276000"},
+					{Name: "沂南县", Code: "371321", PostalCode: "276300"},
+					{Name: "郯城县", Code: "371322", PostalCode: "276100"},
+					{Name: "沂水县", Code: "371323", PostalCode: "276400"},
+					{Name: "兰陵县", Code: "371324", PostalCode: "277700"},
+					{Name: "费县", Code: "371325", PostalCode: "273400"},
+					{Name: "平邑县", Code: "371326", PostalCode: "273300"},
+					{Name: "莒南县", Code: "371327", PostalCode: "276600"},
+					{Name: "蒙阴县", Code: "371328", PostalCode: "276200"},
+					{Name: "临沭县", Code: "371329", PostalCode: "276700"},
+				},
+			},
+			{
+				Name: "德州市", Code: "371400", Population: 561, PhoneCode: "0534", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "德城区", Code: "371402", PostalCode: "253000"},
+					{Name: "陵城区", Code: "371403", PostalCode: "253500"},
+					{Name: "宁津县", Code: "371422", PostalCode: "253400"},
+					{Name: "庆云县", Code: "371423", PostalCode: "253700"},
+					{Name: "临邑县", Code: "371424", PostalCode: "251500"},
+					{Name: "齐河县", Code: "371425", PostalCode: "251100"},
+					{Name: "平原县", Code: "371426", PostalCode: "253100"},
+					{Name: "夏津县", Code: "371427", PostalCode: "253200"},
+					{Name: "武城县", Code: "371428", PostalCode: "253300"},
+					{Name: "乐陵市", Code: "371481", PostalCode: "253600"},
+					{Name: "禹城市", Code: "371482", PostalCode: "251200"},
+				},
+			},
+			{
+				Name: "聊城市", Code: "371500", Population: 595, PhoneCode: "0635", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东昌府区", Code: "371502", PostalCode: "252000"},
+					{Name: "茌平区", Code: "371503", PostalCode: "252100"},
+					{Name: "阳谷县", Code: "371521", PostalCode: "252300"},
+					{Name: "莘县", Code: "371522", PostalCode: "252400"},
+					{Name: "东阿县", Code: "371524", PostalCode: "252200"},
+					{Name: "冠县", Code: "371525", PostalCode: "252500"},
+					{Name: "高唐县", Code: "371526", PostalCode: "252800"},
+					{Name: "临清市", Code: "371581", PostalCode: "252600"},
+				},
+			},
+			{
+				Name: "滨州市", Code: "371600", Population: 393, PhoneCode: "0543", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "滨城区", Code: "371602", PostalCode: "256600"},
+					{Name: "沾化区", Code: "371603", PostalCode: "256800"},
+					{Name: "惠民县", Code: "371621", PostalCode: "251700"},
+					{Name: "阳信县", Code: "371622", PostalCode: "251800"},
+					{Name: "无棣县", Code: "371623", PostalCode: "251900"},
+					{Name: "博兴县", Code: "371625", PostalCode: "256500"},
+					{Name: "邹平市", Code: "371681", PostalCode: "256200"},
+				},
+			},
+			{
+				Name: "菏泽市", Code: "371700", Population: 880, PhoneCode: "0530", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "牡丹区", Code: "371702", PostalCode: "274000"},
+					{Name: "定陶区", Code: "371703", PostalCode: "274100"},
+					{Name: "曹县", Code: "371721", PostalCode: "274400"},
+					{Name: "单县", Code: "371722", PostalCode: "274300"},
+					{Name: "成武县", Code: "371723", PostalCode: "274200"},
+					{Name: "巨野县", Code: "371724", PostalCode: "274900"},
+					{Name: "郓城县", Code: "371725", PostalCode: "274700"},
+					{Name: "鄄城县", Code: "371726", PostalCode: "274600"},
+					{Name: "东明县", Code: "371728", PostalCode: "274500"},
+				},
+			},
 		},
 	},
 	{
-		Name: "河南省", Short: "河南", Code: "41",
+		Name: "河南省", Short: "河南", Abbr: "豫", Code: "41", Population: 9937,
 		Cities: []City{
-			{Name: "郑州市", AreaCodes: []string{"410100", "410102"}},
-			{Name: "开封市", AreaCodes: []string{"410200"}},
-			{Name: "洛阳市", AreaCodes: []string{"410300"}},
-			{Name: "平顶山市", AreaCodes: []string{"410400"}},
-			{Name: "安阳市", AreaCodes: []string{"410500"}},
-			{Name: "新乡市", AreaCodes: []string{"410700"}},
+			{
+				Name: "郑州市", Code: "410100", Population: 1260, PhoneCode: "0371", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "中原区", Code: "410102", PostalCode: "450000"},
+					{Name: "二七区", Code: "410103", PostalCode: "450000"},
+					{Name: "管城回族区", Code: "410104", PostalCode: "450000"},
+					{Name: "金水区", Code: "410105", PostalCode: "450000"},
+					{Name: "上街区", Code: "410106", PostalCode: "450041"},
+					{Name: "惠济区", Code: "410108", PostalCode: "450000"},
+					{Name: "中牟县", Code: "410122", PostalCode: "451450"},
+					{Name: "巩义市", Code: "410181", PostalCode: "451200"},
+					{Name: "荥阳市", Code: "410182", PostalCode: "450100"},
+					{Name: "新密市", Code: "410183", PostalCode: "452300"},
+					{Name: "新郑市", Code: "410184", PostalCode: "451100"},
+					{Name: "登封市", Code: "410185", PostalCode: "452470"},
+				},
+			},
+			{
+				Name: "开封市", Code: "410200", Population: 483, PhoneCode: "0378", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "龙亭区", Code: "410202", PostalCode: "475000"},
+					{Name: "顺河回族区", Code: "410203", PostalCode: "475000"},
+					{Name: "鼓楼区", Code: "410204", PostalCode: "475000"},
+					{Name: "禹王台区", Code: "410205", PostalCode: "475000"},
+					{Name: "祥符区", Code: "410212", PostalCode: "475100"},
+					{Name: "杞县", Code: "410221", PostalCode: "475200"},
+					{Name: "通许县", Code: "410222", PostalCode: "475400"},
+					{Name: "尉氏县", Code: "410223", PostalCode: "475500"},
+					{Name: "兰考县", Code: "410225", PostalCode: "475300"},
+				},
+			},
+			{
+				Name: "洛阳市", Code: "410300", Population: 706, PhoneCode: "0379", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "老城区", Code: "410302", PostalCode: "471000"},
+					{Name: "西工区", Code: "410303", PostalCode: "471000"},
+					{Name: "瀍河回族区", Code: "410304", PostalCode: "471000"},
+					{Name: "涧西区", Code: "410305", PostalCode: "471000"},
+					{Name: "偃师区", Code: "410307", PostalCode: "471900", Since: 2021},
+					{Name: "孟津区", Code: "410308", PostalCode: "471100", Since: 2021},
+					{Name: "洛龙区", Code: "410311", PostalCode: "471000"},
+					{Name: "新安县", Code: "410323", PostalCode: "471800"},
+					{Name: "栾川县", Code: "410324", PostalCode: "471500"},
+					{Name: "嵩县", Code: "410325", PostalCode: "471400"},
+					{Name: "汝阳县", Code: "410326", PostalCode: "471200"},
+					{Name: "宜阳县", Code: "410327", PostalCode: "471600"},
+					{Name: "洛宁县", Code: "410328", PostalCode: "471700"},
+					{Name: "伊川县", Code: "410329", PostalCode: "471300"},
+				},
+			},
+			{
+				Name: "平顶山市", Code: "410400", Population: 499, PhoneCode: "0375", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新华区", Code: "410402", PostalCode: "467000"},
+					{Name: "卫东区", Code: "410403", PostalCode: "467000"},
+					{Name: "石龙区", Code: "410404", PostalCode: "467045"},
+					{Name: "湛河区", Code: "410411", PostalCode: "467000"},
+					{Name: "宝丰县", Code: "410421", PostalCode: "467400"},
+					{Name: "叶县", Code: "410422", PostalCode: "467200"},
+					{Name: "鲁山县", Code: "410423", PostalCode: "467300"},
+					{Name: "郏县", Code: "410425", PostalCode: "467100"},
+					{Name: "舞钢市", Code: "410481", PostalCode: "462500"},
+					{Name: "汝州市", Code: "410482", PostalCode: "467500"},
+				},
+			},
+			{
+				Name: "安阳市", Code: "410500", Population: 548, PhoneCode: "0372", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "文峰区", Code: "410502", PostalCode: "455000"},
+					{Name: "北关区", Code: "410503", PostalCode: "455000"},
+					{Name: "殷都区", Code: "410505", PostalCode: "455000"},
+					{Name: "龙安区", Code: "410506", PostalCode: "455000"},
+					{Name: "安阳县", Code: "410522", PostalCode: "455000"},
+					{Name: "汤阴县", Code: "410523", PostalCode: "456150"},
+					{Name: "滑县", Code: "410526", PostalCode: "456400"},
+					{Name: "内黄县", Code: "410527", PostalCode: "456300"},
+					{Name: "林州市", Code: "410581", PostalCode: "456550"},
+				},
+			},
+			{
+				Name: "鹤壁市", Code: "410600", Population: 157, PhoneCode: "0392", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "鹤山区", Code: "410602", PostalCode: "458000"},
+					{Name: "山城区", Code: "410603", PostalCode: "458000"},
+					{Name: "淇滨区", Code: "410611", PostalCode: "458000"},
+					{Name: "浚县", Code: "410621", PostalCode: "456250"},
+					{Name: "淇县", Code: "410622", PostalCode: "456750"},
+				},
+			},
+			{
+				Name: "新乡市", Code: "410700", Population: 625, PhoneCode: "0373", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "红旗区", Code: "410702", PostalCode: "453000"},
+					{Name: "卫滨区", Code: "410703", PostalCode: "453000"},
+					{Name: "凤泉区", Code: "410704", PostalCode: "453000"},
+					{Name: "牧野区", Code: "410711", PostalCode: "453000"},
+					{Name: "新乡县", Code: "410721", PostalCode: "453700"},
+					{Name: "获嘉县", Code: "410724", PostalCode: "453800"},
+					{Name: "原阳县", Code: "410725", PostalCode: "453500"},
+					{Name: "延津县", Code: "410726", PostalCode: "453200"},
+					{Name: "封丘县", Code: "410727", PostalCode: "453300"},
+					{Name: "卫辉市", Code: "410781", PostalCode: "453100"},
+					{Name: "辉县市", Code: "410782", PostalCode: "453600"},
+					{Name: "长垣市", Code: "410783", PostalCode: "453400"},
+				},
+			},
+			{
+				Name: "焦作市", Code: "410800", Population: 352, PhoneCode: "0391", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "解放区", Code: "410802", PostalCode: "454000"},
+					{Name: "中站区", Code: "410803", PostalCode: "454000"},
+					{Name: "马村区", Code: "410804", PostalCode: "454000"},
+					{Name: "山阳区", Code: "410811", PostalCode: "454000"},
+					{Name: "修武县", Code: "410821", PostalCode: "454350"},
+					{Name: "博爱县", Code: "410822", PostalCode: "454450"},
+					{Name: "武陟县", Code: "410823", PostalCode: "454950"},
+					{Name: "温县", Code: "410825", PostalCode: "454850"},
+					{Name: "沁阳市", Code: "410882", PostalCode: "454550"},
+					{Name: "孟州市", Code: "410883", PostalCode: "454750"},
+				},
+			},
+			{
+				Name: "濮阳市", Code: "410900", Population: 377, PhoneCode: "0393", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "华龙区", Code: "410902", PostalCode: "457001"},
+					{Name: "清丰县", Code: "410922", PostalCode: "457300"},
+					{Name: "南乐县", Code: "410923", PostalCode: "457400"},
+					{Name: "范县", Code: "410926", PostalCode: "457500"},
+					{Name: "台前县", Code: "410927", PostalCode: "457600"},
+					{Name: "濮阳县", Code: "410928", PostalCode: "457100"},
+				},
+			},
+			{
+				Name: "许昌市", Code: "411000", Population: 438, PhoneCode: "0374", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "魏都区", Code: "411002", PostalCode: "461000"},
+					{Name: "建安区", Code: "411003", PostalCode: "461100"},
+					{Name: "鄢陵县", Code: "411024", PostalCode: "461200"},
+					{Name: "襄城县", Code: "411025", PostalCode: "452670"},
+					{Name: "禹州市", Code: "411081", PostalCode: "461670"},
+					{Name: "长葛市", Code: "411082", PostalCode: "461500"},
+				},
+			},
+			{
+				Name: "漯河市", Code: "411100", Population: 237, PhoneCode: "0395", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "源汇区", Code: "411102", PostalCode: "462000"},
+					{Name: "郾城区", Code: "411103", PostalCode: "462000"},
+					{Name: "召陵区", Code: "411104", PostalCode: "462000"},
+					{Name: "舞阳县", Code: "411121", PostalCode: "462400"},
+					{Name: "临颍县", Code: "411122", PostalCode: "462600"},
+				},
+			},
+			{
+				Name: "三门峡市", Code: "411200", Population: 203, PhoneCode: "0398", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "湖滨区", Code: "411202", PostalCode: "472000"},
+					{Name: "陕州区", Code: "411203", PostalCode: "472100"},
+					{Name: "渑池县", Code: "411221", PostalCode: "472400"},
+					{Name: "卢氏县", Code: "411224", PostalCode: "472200"},
+					{Name: "义马市", Code: "411281", PostalCode: "472300"},
+					{Name: "灵宝市", Code: "411282", PostalCode: "472500"},
+				},
+			},
+			{
+				Name: "南阳市", Code: "411300", Population: 971, PhoneCode: "0377", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "宛城区", Code: "411302", PostalCode: "473000"},
+					{Name: "卧龙区", Code: "411303", PostalCode: "473000"},
+					{Name: "南召县", Code: "411321", PostalCode: "474650"},
+					{Name: "方城县", Code: "411322", PostalCode: "473200"},
+					{Name: "西峡县", Code: "411323", PostalCode: "474550"},
+					{Name: "镇平县", Code: "411324", PostalCode: "474250"},
+					{Name: "内乡县", Code: "411325", PostalCode: "474350"},
+					{Name: "淅川县", Code: "411326", PostalCode: "474450"},
+					{Name: "社旗县", Code: "411327", PostalCode: "473300"},
+					{Name: "唐河县", Code: "411328", PostalCode: "473400"},
+					{Name: "新野县", Code: "411329", PostalCode: "473500"},
+					{Name: "桐柏县", Code: "411330", PostalCode: "474750"},
+					{Name: "邓州市", Code: "411381", PostalCode: "474150"},
+				},
+			},
+			{
+				Name: "商丘市", Code: "411400", Population: 782, PhoneCode: "0370", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "梁园区", Code: "411402", PostalCode: "476000"},
+					{Name: "睢阳区", Code: "411403", PostalCode: "476000"},
+					{Name: "民权县", Code: "411421", PostalCode: "476800"},
+					{Name: "睢县", Code: "411422", PostalCode: "476900"},
+					{Name: "宁陵县", Code: "411423", PostalCode: "476700"},
+					{Name: "柘城县", Code: "411424", PostalCode: "476200"},
+					{Name: "虞城县", Code: "411425", PostalCode: "476300"},
+					{Name: "夏邑县", Code: "411426", PostalCode: "476400"},
+					{Name: "永城市", Code: "411481", PostalCode: "476600"},
+				},
+			},
+			{
+				Name: "信阳市", Code: "411500", Population: 623, PhoneCode: "0376", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "浉河区", Code: "411502", PostalCode: "464000"},
+					{Name: "平桥区", Code: "411503", PostalCode: "464000"},
+					{Name: "罗山县", Code: "411521", PostalCode: "464200"},
+					{Name: "光山县", Code: "411522", PostalCode: "465400"},
+					{Name: "新县", Code: "411523", PostalCode: "465550"},
+					{Name: "商城县", Code: "411524", PostalCode: "465350"},
+					{Name: "固始县", Code: "411525", PostalCode: "465200"},
+					{Name: "潢川县", Code: "411526", PostalCode: "465150"},
+					{Name: "淮滨县", Code: "411527", PostalCode: "464400"},
+					{Name: "息县", Code: "411528", PostalCode: "464300"},
+				},
+			},
+			{
+				Name: "周口市", Code: "411600", Population: 903, PhoneCode: "0394", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "川汇区", Code: "411602", PostalCode: "466000"},
+					{Name: "淮阳区", Code: "411603", PostalCode: "466700"},
+					{Name: "扶沟县", Code: "411621", PostalCode: "461300"},
+					{Name: "西华县", Code: "411622", PostalCode: "466600"},
+					{Name: "商水县", Code: "411623", PostalCode: "466100"},
+					{Name: "沈丘县", Code: "411624", PostalCode: "466300"},
+					{Name: "郸城县", Code: "411625", PostalCode: "477150"},
+					{Name: "太康县", Code: "411627", PostalCode: "461400"},
+					{Name: "鹿邑县", Code: "411628", PostalCode: "477200"},
+					{Name: "项城市", Code: "411681", PostalCode: "466200"},
+				},
+			},
+			{
+				Name: "驻马店市", Code: "411700", Population: 701, PhoneCode: "0396", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "驿城区", Code: "411702", PostalCode: "463000"},
+					{Name: "西平县", Code: "411721", PostalCode: "463900"},
+					{Name: "上蔡县", Code: "411722", PostalCode: "463800"},
+					{Name: "平舆县", Code: "411723", PostalCode: "463400"},
+					{Name: "正阳县", Code: "411724", PostalCode: "463600"},
+					{Name: "确山县", Code: "411725", PostalCode: "463200"},
+					{Name: "泌阳县", Code: "411726", PostalCode: "463700"},
+					{Name: "汝南县", Code: "411727", PostalCode: "463300"},
+					{Name: "遂平县", Code: "411728", PostalCode: "463100"},
+					{Name: "新蔡县", Code: "411729", PostalCode: "463500"},
+				},
+			},
+			{
+				Name: "济源市", Code: "419001", Population: 73, PhoneCode: "0391", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "济源市", Code: "419001", PostalCode: "459000"},
+				},
+			},
 		},
 	},
 	{
-		Name: "湖北省", Short: "湖北", Code: "42",
+		Name: "湖北省", Short: "湖北", Abbr: "鄂", Code: "42", Population: 5775,
 		Cities: []City{
-			{Name: "武汉市", AreaCodes: []string{"420100", "420102"}},
-			{Name: "黄石市", AreaCodes: []string{"420200"}},
-			{Name: "十堰市", AreaCodes: []string{"420300"}},
-			{Name: "宜昌市", AreaCodes: []string{"420500"}},
-			{Name: "襄阳市", AreaCodes: []string{"420600"}},
-			{Name: "荆州市", AreaCodes: []string{"421000"}},
+			{
+				Name: "武汉市", Code: "420100", Population: 1232, PhoneCode: "027", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "江岸区", Code: "420102", PostalCode: "430014"},
+					{Name: "江汉区", Code: "420103", PostalCode: "430021"},
+					{Name: "硚口区", Code: "420104", PostalCode: "430033"},
+					{Name: "汉阳区", Code: "420105", PostalCode: "430050"},
+					{Name: "武昌区", Code: "420106", PostalCode: "430061"},
+					{Name: "青山区", Code: "420107", PostalCode: "430080"},
+					{Name: "洪山区", Code: "420111", PostalCode: "430070"},
+					{Name: "东西湖区", Code: "420112", PostalCode: "430040"},
+					{Name: "汉南区", Code: "420113", PostalCode: "430090"},
+					{Name: "蔡甸区", Code: "420114", PostalCode: "430100"},
+					{Name: "江夏区", Code: "420115", PostalCode: "430200"},
+					{Name: "黄陂区", Code: "420116", PostalCode: "430300"},
+					{Name: "新洲区", Code: "420117", PostalCode: "431400"},
+				},
+			},
+			{
+				Name: "黄石市", Code: "420200", Population: 247, PhoneCode: "0714", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "黄石港区", Code: "420202", PostalCode: "435000"},
+					{Name: "西塞山区", Code: "420203", PostalCode: "435000"},
+					{Name: "下陆区", Code: "420204", PostalCode: "435000"},
+					{Name: "铁山区", Code: "420205", PostalCode: "435006"},
+					{Name: "阳新县", Code: "420222", PostalCode: "435200"},
+					{Name: "大冶市", Code: "420281", PostalCode: "435100"},
+				},
+			},
+			{
+				Name: "十堰市", Code: "420300", Population: 321, PhoneCode: "0719", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "茅箭区", Code: "420302", PostalCode: "442000"},
+					{Name: "张湾区", Code: "420303", PostalCode: "442000"},
+					{Name: "郧阳区", Code: "420304", PostalCode: "442500", Since: 2014},
+					{Name: "郧西县", Code: "420322", PostalCode: "442600"},
+					{Name: "竹山县", Code: "420323", PostalCode: "442200"},
+					{Name: "竹溪县", Code: "420324", PostalCode: "442300"},
+					{Name: "房县", Code: "420325", PostalCode: "442100"},
+					{Name: "丹江口市", Code: "420381", PostalCode: "442700"},
+				},
+			},
+			{
+				Name: "宜昌市", Code: "420500", Population: 401, PhoneCode: "0717", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "西陵区", Code: "420502", PostalCode: "443000"},
+					{Name: "伍家岗区", Code: "420503", PostalCode: "443000"},
+					{Name: "点军区", Code: "420504", PostalCode: "443000"},
+					{Name: "猇亭区", Code: "420505", PostalCode: "443007"},
+					{Name: "夷陵区", Code: "420506", PostalCode: "443100"},
+					{Name: "远安县", Code: "420525", PostalCode: "444200"},
+					{Name: "兴山县", Code: "420526", PostalCode: "443700"},
+					{Name: "秭归县", Code: "420527", PostalCode: "443600"},
+					{Name: "长阳土家族自治县", Code: "420528", PostalCode: "443500"},
+					{Name: "五峰土家族自治县", Code: "420529", PostalCode: "443400"},
+					{Name: "宜都市", Code: "420581", PostalCode: "443300"},
+					{Name: "当阳市", Code: "420582", PostalCode: "444100"},
+					{Name: "枝江市", Code: "420583", PostalCode: "443200"},
+				},
+			},
+			{
+				Name: "襄阳市", Code: "420600", Population: 526, PhoneCode: "0710", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "襄城区", Code: "420602", PostalCode: "441000"},
+					{Name: "樊城区", Code: "420606", PostalCode: "441000"},
+					{Name: "襄州区", Code: "420607", PostalCode: "441100", Since: 2010},
+					{Name: "南漳县", Code: "420624", PostalCode: "441500"},
+					{Name: "谷城县", Code: "420625", PostalCode: "441700"},
+					{Name: "保康县", Code: "420626", PostalCode: "441600"},
+					{Name: "老河口市", Code: "420682", PostalCode: "441800"},
+					{Name: "枣阳市", Code: "420683", PostalCode: "441200"},
+					{Name: "宜城市", Code: "420684", PostalCode: "441400"},
+				},
+			},
+			{
+				Name: "鄂州市", Code: "420700", Population: 108, PhoneCode: "0711", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "梁子湖区", Code: "420702", PostalCode: "436064"},
+					{Name: "华容区", Code: "420703", PostalCode: "436000"},
+					{Name: "鄂城区", Code: "420704", PostalCode: "436000"},
+				},
+			},
+			{
+				Name: "荆门市", Code: "420800", Population: 260, PhoneCode: "0724", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东宝区", Code: "420802", PostalCode: "448000"},
+					{Name: "掇刀区", Code: "420804", PostalCode: "448000"},
+					{Name: "沙洋县", Code: "420822", PostalCode: "448200"},
+					{Name: "钟祥市", Code: "420881", PostalCode: "431900"},
+					{Name: "京山市", Code: "420882", PostalCode: "431800", Since: 2018},
+				},
+			},
+			{
+				Name: "孝感市", Code: "420900", Population: 427, PhoneCode: "0712", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "孝南区", Code: "420902", PostalCode: "432100"},
+					{Name: "孝昌县", Code: "420921", PostalCode: "432900"},
+					{Name: "大悟县", Code: "420922", PostalCode: "432800"},
+					{Name: "云梦县", Code: "420923", PostalCode: "432500"},
+					{Name: "应城市", Code: "420981", PostalCode: "432400"},
+					{Name: "安陆市", Code: "420982", PostalCode: "432600"},
+					{Name: "汉川市", Code: "420984", PostalCode: "432300"},
+				},
+			},
+			{
+				Name: "荆州市", Code: "421000", Population: 523, PhoneCode: "0716", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "沙市区", Code: "421002", PostalCode: "434000"},
+					{Name: "荆州区", Code: "421003", PostalCode: "434020"},
+					{Name: "公安县", Code: "421022", PostalCode: "434300"},
+					{Name: "江陵县", Code: "421024", PostalCode: "434100"},
+					{Name: "石首市", Code: "421081", PostalCode: "434400"},
+					{Name: "洪湖市", Code: "421083", PostalCode: "433200"},
+					{Name: "松滋市", Code: "421087", PostalCode: "434200"},
+					{Name: "监利市", Code: "421088", PostalCode: "433300", Since: 2020},
+				},
+			},
+			{
+				Name: "黄冈市", Code: "421100", Population: 588, PhoneCode: "0713", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "黄州区", Code: "421102", PostalCode: "438000"},
+					{Name: "团风县", Code: "421121", PostalCode: "438800"},
+					{Name: "红安县", Code: "421122", PostalCode: "438400"},
+					{Name: "罗田县", Code: "421123", PostalCode: "438600"},
+					{Name: "英山县", Code: "421124", PostalCode: "438700"},
+					{Name: "浠水县", Code: "421125", PostalCode: "438200"},
+					{Name: "蕲春县", Code: "421126", PostalCode: "435300"},
+					{Name: "黄梅县", Code: "421127", PostalCode: "435500"},
+					{Name: "麻城市", Code: "421181", PostalCode: "438300"},
+					{Name: "武穴市", Code: "421182", PostalCode: "435400"},
+				},
+			},
+			{
+				Name: "咸宁市", Code: "421200", Population: 265, PhoneCode: "0715", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "咸安区", Code: "421202", PostalCode: "437000"},
+					{Name: "嘉鱼县", Code: "421221", PostalCode: "437200"},
+					{Name: "通城县", Code: "421222", PostalCode: "437400"},
+					{Name: "崇阳县", Code: "421223", PostalCode: "437500"},
+					{Name: "通山县", Code: "421224", PostalCode: "437600"},
+					{Name: "赤壁市", Code: "421281", PostalCode: "437300"},
+				},
+			},
+			{
+				Name: "随州市", Code: "421300", Population: 205, PhoneCode: "0722", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "曾都区", Code: "421303", PostalCode: "441300"},
+					{Name: "随县", Code: "421321", PostalCode: "441309"},
+					{Name: "广水市", Code: "421381", PostalCode: "432700"},
+				},
+			},
+			{
+				Name: "恩施土家族苗族自治州", Code: "422800", Population: 346, PhoneCode: "0718", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "恩施市", Code: "422801", PostalCode: "445000"},
+					{Name: "利川市", Code: "422802", PostalCode: "445400"},
+					{Name: "建始县", Code: "422822", PostalCode: "445300"},
+					{Name: "巴东县", Code: "422823", PostalCode: "444300"},
+					{Name: "宣恩县", Code: "422825", PostalCode: "445500"},
+					{Name: "咸丰县", Code: "422826", PostalCode: "445600"},
+					{Name: "来凤县", Code: "422827", PostalCode: "445700"},
+					{Name: "鹤峰县", Code: "422828", PostalCode: "445800"},
+				},
+			},
+			{
+				Name: "仙桃市", Code: "429004", Population: 113, PhoneCode: "0728", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "仙桃市", Code: "429004", PostalCode: "433000"},
+				},
+			},
+			{
+				Name: "潜江市", Code: "429005", Population: 89, PhoneCode: "0728", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "潜江市", Code: "429005", PostalCode: "433100"},
+				},
+			},
+			{
+				Name: "天门市", Code: "429006", Population: 116, PhoneCode: "0728", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "天门市", Code: "429006", PostalCode: "431700"},
+				},
+			},
+			{
+				Name: "神农架林区", Code: "429021", Population: 7, PhoneCode: "0719", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "神农架林区", Code: "429021", PostalCode: "442400"},
+				},
+			},
 		},
 	},
 	{
-		Name: "湖南省", Short: "湖南", Code: "43",
+		Name: "湖南省", Short: "湖南", Abbr: "湘", Code: "43", Population: 6644,
 		Cities: []City{
-			{Name: "长沙市", AreaCodes: []string{"430100", "430102"}},
-			{Name: "株洲市", AreaCodes: []string{"430200"}},
-			{Name: "湘潭市", AreaCodes: []string{"430300"}},
-			{Name: "衡阳市", AreaCodes: []string{"430400"}},
-			{Name: "邵阳市", AreaCodes: []string{"430500"}},
-			{Name: "岳阳市", AreaCodes: []string{"430600"}},
+			{
+				Name: "长沙市", Code: "430100", Population: 1005, PhoneCode: "0731", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "芙蓉区", Code: "430102", PostalCode: "410000"},
+					{Name: "天心区", Code: "430103", PostalCode: "410000"},
+					{Name: "岳麓区", Code: "430104", PostalCode: "410000"},
+					{Name: "开福区", Code: "430105", PostalCode: "410000"},
+					{Name: "雨花区", Code: "430111", PostalCode: "410000"},
+					{Name: "望城区", Code: "430112", PostalCode: "410200", Since: 2011},
+					{Name: "长沙县", Code: "430121", PostalCode: "410100"},
+					{Name: "浏阳市", Code: "430181", PostalCode: "410300"},
+					{Name: "宁乡市", Code: "430182", PostalCode: "410600", Since: 2017},
+				},
+			},
+			{
+				Name: "株洲市", Code: "430200", Population: 390, PhoneCode: "0731", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "荷塘区", Code: "430202", PostalCode: "412000"},
+					{Name: "芦淞区", Code: "430203", PostalCode: "412000"},
+					{Name: "石峰区", Code: "430204", PostalCode: "412000"},
+					{Name: "天元区", Code: "430211", PostalCode: "412000"},
+					{Name: "渌口区", Code: "430212", PostalCode: "412100", Since: 2018},
+					{Name: "攸县", Code: "430223", PostalCode: "412300"},
+					{Name: "茶陵县", Code: "430224", PostalCode: "412400"},
+					{Name: "炎陵县", Code: "430225", PostalCode: "412500"},
+					{Name: "醴陵市", Code: "430281", PostalCode: "412200"},
+				},
+			},
+			{
+				Name: "湘潭市", Code: "430300", Population: 273, PhoneCode: "0731", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "雨湖区", Code: "430302", PostalCode: "411100"},
+					{Name: "岳塘区", Code: "430304", PostalCode: "411101"},
+					{Name: "湘潭县", Code: "430321", PostalCode: "411200"},
+					{Name: "湘乡市", Code: "430381", PostalCode: "411400"},
+					{Name: "韶山市", Code: "430382", PostalCode: "411300"},
+				},
+			},
+			{
+				Name: "衡阳市", Code: "430400", Population: 665, PhoneCode: "0734", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "珠晖区", Code: "430405", PostalCode: "421000"},
+					{Name: "雁峰区", Code: "430406", PostalCode: "421000"},
+					{Name: "石鼓区", Code: "430407", PostalCode: "421000"},
+					{Name: "蒸湘区", Code: "430408", PostalCode: "421000"},
+					{Name: "南岳区", Code: "430412", PostalCode: "421900"},
+					{Name: "衡阳县", Code: "430421", PostalCode: "421200"},
+					{Name: "衡南县", Code: "430422", PostalCode: "421100"},
+					{Name: "衡山县", Code: "430423", PostalCode: "421300"},
+					{Name: "衡东县", Code: "430424", PostalCode: "421400"},
+					{Name: "祁东县", Code: "430426", PostalCode: "421600"},
+					{Name: "耒阳市", Code: "430481", PostalCode: "421800"},
+					{Name: "常宁市", Code: "430482", PostalCode: "421500"},
+				},
+			},
+			{
+				Name: "邵阳市", Code: "430500", Population: 656, PhoneCode: "0739", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "双清区", Code: "430502", PostalCode: "422000"},
+					{Name: "大祥区", Code: "430503", PostalCode: "422000"},
+					{Name: "北塔区", Code: "430511", PostalCode: "422000"},
+					{Name: "新邵县", Code: "430522", PostalCode: "422900"},
+					{Name: "邵阳县", Code: "430523", PostalCode: "422100"},
+					{Name: "隆回县", Code: "430524", PostalCode: "422200"},
+					{Name: "洞口县", Code: "430525", PostalCode: "422300"},
+					{Name: "绥宁县", Code: "430527", PostalCode: "422600"},
+					{Name: "新宁县", Code: "430528", PostalCode: "422700"},
+					{Name: "城步苗族自治县", Code: "430529", PostalCode: "422500"},
+					{Name: "武冈市", Code: "430581", PostalCode: "422400"},
+					{Name: "邵东市", Code: "430582", PostalCode: "422800"},
+				},
+			},
+			{
+				Name: "岳阳市", Code: "430600", Population: 505, PhoneCode: "0730", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "岳阳楼区", Code: "430602", PostalCode: "414000"},
+					{Name: "云溪区", Code: "430603", PostalCode: "414009"},
+					{Name: "君山区", Code: "430611", PostalCode: "414005"},
+					{Name: "岳阳县", Code: "430621", PostalCode: "414100"},
+					{Name: "华容县", Code: "430623", PostalCode: "414200"},
+					{Name: "湘阴县", Code: "430624", PostalCode: "414600"},
+					{Name: "平江县", Code: "430626", PostalCode: "414500"},
+					{Name: "汨罗市", Code: "430681", PostalCode: "414400"},
+					{Name: "临湘市", Code: "430682", PostalCode: "414300"},
+				},
+			},
+			{
+				Name: "常德市", Code: "430700", Population: 528, PhoneCode: "0736", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "武陵区", Code: "430702", PostalCode: "415000"},
+					{Name: "鼎城区", Code: "430703", PostalCode: "415100"},
+					{Name: "安乡县", Code: "430721", PostalCode: "415600"},
+					{Name: "汉寿县", Code: "430722", PostalCode: "415900"},
+					{Name: "澧县", Code: "430723", PostalCode: "415500"},
+					{Name: "临澧县", Code: "430724", PostalCode: "415200"},
+					{Name: "桃源县", Code: "430725", PostalCode: "415700"},
+					{Name: "石门县", Code: "430726", PostalCode: "415300"},
+					{Name: "津市市", Code: "430781", PostalCode: "415400"},
+				},
+			},
+			{
+				Name: "张家界市", Code: "430800", Population: 152, PhoneCode: "0744", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "永定区", Code: "430802", PostalCode: "427000"},
+					{Name: "武陵源区", Code: "430811", PostalCode: "427400"},
+					{Name: "慈利县", Code: "430821", PostalCode: "427200"},
+					{Name: "桑植县", Code: "430822", PostalCode: "427100"},
+				},
+			},
+			{
+				Name: "益阳市", Code: "430900", Population: 385, PhoneCode: "0737", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "资阳区", Code: "430902", PostalCode: "413000"},
+					{Name: "赫山区", Code: "430903", PostalCode: "413000"},
+					{Name: "南县", Code: "430921", PostalCode: "413200"},
+					{Name: "桃江县", Code: "430922", PostalCode: "413400"},
+					{Name: "安化县", Code: "430923", PostalCode: "413500"},
+					{Name: "沅江市", Code: "430981", PostalCode: "413100"},
+				},
+			},
+			{
+				Name: "郴州市", Code: "431000", Population: 467, PhoneCode: "0735", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "北湖区", Code: "431002", PostalCode: "423000"},
+					{Name: "苏仙区", Code: "431003", PostalCode: "423000"},
+					{Name: "桂阳县", Code: "431021", PostalCode: "424400"},
+					{Name: "宜章县", Code: "431022", PostalCode: "424200"},
+					{Name: "永兴县", Code: "431023", PostalCode: "423300"},
+					{Name: "嘉禾县", Code: "431024", PostalCode: "424500"},
+					{Name: "临武县", Code: "431025", PostalCode: "424300"},
+					{Name: "汝城县", Code: "431026", PostalCode: "424100"},
+					{Name: "桂东县", Code: "431027", PostalCode: "423500"},
+					{Name: "安仁县", Code: "431028", PostalCode: "423600"},
+					{Name: "资兴市", Code: "431081", PostalCode: "423400"},
+				},
+			},
+			{
+				Name: "永州市", Code: "431100", Population: 529, PhoneCode: "0746", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "零陵区", Code: "431102", PostalCode: "425100"},
+					{Name: "冷水滩区", Code: "431103", PostalCode: "425000"},
+					{Name: "东安县", Code: "431122", PostalCode: "425900"},
+					{Name: "双牌县", Code: "431123", PostalCode: "425200"},
+					{Name: "道县", Code: "431124", PostalCode: "425300"},
+					{Name: "江永县", Code: "431125", PostalCode: "425400"},
+					{Name: "宁远县", Code: "431126", PostalCode: "425600"},
+					{Name: "蓝山县", Code: "431127", PostalCode: "425800"},
+					{Name: "新田县", Code: "431128", PostalCode: "425700"},
+					{Name: "江华瑶族自治县", Code: "431129", PostalCode: "425500"},
+					{Name: "祁阳市", Code: "431181", PostalCode: "426100"},
+				},
+			},
+			{
+				Name: "怀化市", Code: "431200", Population: 459, PhoneCode: "0745", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "鹤城区", Code: "431202", PostalCode: "418000"},
+					{Name: "中方县", Code: "431221", PostalCode: "418005"},
+					{Name: "沅陵县", Code: "431222", PostalCode: "419600"},
+					{Name: "辰溪县", Code: "431223", PostalCode: "419500"},
+					{Name: "溆浦县", Code: "431224", PostalCode: "419300"},
+					{Name: "会同县", Code: "431225", PostalCode: "418300"},
+					{Name: "麻阳苗族自治县", Code: "431226", PostalCode: "419400"},
+					{Name: "新晃侗族自治县", Code: "431227", PostalCode: "419200"},
+					{Name: "芷江侗族自治县", Code: "431228", PostalCode: "419100"},
+					{Name: "靖州苗族侗族自治县", Code: "431229", PostalCode: "418400"},
+					{Name: "通道侗族自治县", Code: "431230", PostalCode: "418500"},
+					{Name: "洪江市", Code: "431281", PostalCode: "418100"},
+				},
+			},
+			{
+				Name: "娄底市", Code: "431300", Population: 383, PhoneCode: "0738", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "娄星区", Code: "431302", PostalCode: "417000"},
+					{Name: "双峰县", Code: "431321", PostalCode: "417700"},
+					{Name: "新化县", Code: "431322", PostalCode: "417600"},
+					{Name: "冷水江市", Code: "431381", PostalCode: "417500"},
+					{Name: "涟源市", Code: "431382", PostalCode: "417100"},
+				},
+			},
+			{
+				Name: "湘西土家族苗族自治州", Code: "433100", Population: 249, PhoneCode: "0743", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "吉首市", Code: "433101", PostalCode: "416000"},
+					{Name: "泸溪县", Code: "433122", PostalCode: "416100"},
+					{Name: "凤凰县", Code: "433123", PostalCode: "416200"},
+					{Name: "花垣县", Code: "433124", PostalCode: "416400"},
+					{Name: "保靖县", Code: "433125", PostalCode: "416500"},
+					{Name: "古丈县", Code: "433126", PostalCode: "416300"},
+					{Name: "永顺县", Code: "433127", PostalCode: "416700"},
+					{Name: "龙山县", Code: "433130", PostalCode: "416800"},
+				},
+			},
 		},
 	},
 	{
-		Name: "广东省", Short: "广东", Code: "44",
+		Name: "广东省", Short: "广东", Abbr: "粤", Code: "44", Population: 12601,
 		Cities: []City{
-			{Name: "广州市", AreaCodes: []string{"440100", "440103", "440104", "440105"}},
-			{Name: "深圳市", AreaCodes: []string{"440300", "440303", "440304", "440305"}},
-			{Name: "珠海市", AreaCodes: []string{"440400"}},
-			{Name: "汕头市", AreaCodes: []string{"440500"}},
-			{Name: "佛山市", AreaCodes: []string{"440600"}},
-			{Name: "东莞市", AreaCodes: []string{"441900"}},
-			{Name: "中山市", AreaCodes: []string{"442000"}},
-			{Name: "惠州市", AreaCodes: []string{"441300"}},
+			{
+				Name: "广州市", Code: "440100", Population: 1868, PhoneCode: "020", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "荔湾区", Code: "440103", PostalCode: "510145"},
+					{Name: "越秀区", Code: "440104", PostalCode: "510030"},
+					{Name: "海珠区", Code: "440105", PostalCode: "510220"},
+					{Name: "天河区", Code: "440106", PostalCode: "510630"},
+					{Name: "白云区", Code: "440111", PostalCode: "510080"},
+					{Name: "黄埔区", Code: "440112", PostalCode: "510700"},
+					{Name: "番禺区", Code: "440113", PostalCode: "511400", Since: 2000},
+					{Name: "花都区", Code: "440114", PostalCode: "510800", Since: 2000},
+					{Name: "南沙区", Code: "440115", PostalCode: "511458"},
+					{Name: "从化区", Code: "440117", PostalCode: "510900", Since: 2014},
+					{Name: "增城区", Code: "440118", PostalCode: "511300", Since: 2014},
+				},
+			},
+			{
+				Name: "韶关市", Code: "440200", Population: 286, PhoneCode: "0751", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "武江区", Code: "440203", PostalCode: "512026"},
+					{Name: "浈江区", Code: "440204", PostalCode: "512023"},
+					{Name: "曲江区", Code: "440205", PostalCode: "512100"},
+					{Name: "始兴县", Code: "440222", PostalCode: "512500"},
+					{Name: "仁化县", Code: "440224", PostalCode: "512300"},
+					{Name: "翁源县", Code: "440229", PostalCode: "512600"},
+					{Name: "乳源瑶族自治县", Code: "440232", PostalCode: "512700"},
+					{Name: "新丰县", Code: "440233", PostalCode: "511100"},
+					{Name: "乐昌市", Code: "440281", PostalCode: "512200"},
+					{Name: "南雄市", Code: "440282", PostalCode: "512400"},
+				},
+			},
+			{
+				Name: "深圳市", Code: "440300", Population: 1756, PhoneCode: "0755", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "罗湖区", Code: "440303", PostalCode: "518001"},
+					{Name: "福田区", Code: "440304", PostalCode: "518033"},
+					{Name: "南山区", Code: "440305", PostalCode: "518052"},
+					{Name: "宝安区", Code: "440306", PostalCode: "518101"},
+					{Name: "龙岗区", Code: "440307", PostalCode: "518116"},
+					{Name: "盐田区", Code: "440308", PostalCode: "518081"},
+					{Name: "龙华区", Code: "440309", PostalCode: "518109", Since: 2016},
+					{Name: "坪山区", Code: "440310", PostalCode: "518118", Since: 2016},
+					{Name: "光明区", Code: "440311", PostalCode: "518107", Since: 2018},
+				},
+			},
+			{
+				Name: "珠海市", Code: "440400", Population: 244, PhoneCode: "0756", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "香洲区", Code: "440402", PostalCode: "519000"},
+					{Name: "斗门区", Code: "440403", PostalCode: "519100"},
+					{Name: "金湾区", Code: "440404", PostalCode: "519090"},
+				},
+			},
+			{
+				Name: "汕头市", Code: "440500", Population: 550, PhoneCode: "0754", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "龙湖区", Code: "440507", PostalCode: "515041"},
+					{Name: "金平区", Code: "440511", PostalCode: "515041"},
+					{Name: "濠江区", Code: "440512", PostalCode: "515071"},
+					{Name: "潮阳区", Code: "440513", PostalCode: "515100"},
+					{Name: "潮南区", Code: "440514", PostalCode: "515144"},
+					{Name: "澄海区", Code: "440515", PostalCode: "515800"},
+					{Name: "南澳县", Code: "440523", PostalCode: "515900"},
+				},
+			},
+			{
+				Name: "佛山市", Code: "440600", Population: 950, PhoneCode: "0757", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "禅城区", Code: "440604", PostalCode: "528000", Since: 2002},
+					{Name: "南海区", Code: "440605", PostalCode: "528200", Since: 2002},
+					{Name: "顺德区", Code: "440606", PostalCode: "528300", Since: 2002},
+					{Name: "三水区", Code: "440607", PostalCode: "528100", Since: 2002},
+					{Name: "高明区", Code: "440608", PostalCode: "528500", Since: 2002},
+				},
+			},
+			{
+				Name: "江门市", Code: "440700", Population: 480, PhoneCode: "0750", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "蓬江区", Code: "440703", PostalCode: "529000"},
+					{Name: "江海区", Code: "440704", PostalCode: "529040"},
+					{Name: "新会区", Code: "440705", PostalCode: "529100"},
+					{Name: "台山市", Code: "440781", PostalCode: "529200"},
+					{Name: "开平市", Code: "440783", PostalCode: "529300"},
+					{Name: "鹤山市", Code: "440784", PostalCode: "529700"},
+					{Name: "恩平市", Code: "440785", PostalCode: "529400"},
+				},
+			},
+			{
+				Name: "湛江市", Code: "440800", Population: 698, PhoneCode: "0759", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "赤坎区", Code: "440802", PostalCode: "524000"},
+					{Name: "霞山区", Code: "440803", PostalCode: "524000"},
+					{Name: "坡头区", Code: "440804", PostalCode: "524000"},
+					{Name: "麻章区", Code: "440811", PostalCode: "524094"},
+					{Name: "遂溪县", Code: "440823", PostalCode: "524300"},
+					{Name: "徐闻县", Code: "440825", PostalCode: "524100"},
+					{Name: "廉江市", Code: "440881", PostalCode: "524400"},
+					{Name: "雷州市", Code: "440882", PostalCode: "524200"},
+					{Name: "吴川市", Code: "440883", PostalCode: "524500"},
+				},
+			},
+			{
+				Name: "茂名市", Code: "440900", Population: 618, PhoneCode: "0668", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "茂南区", Code: "440902", PostalCode: "525000"},
+					{Name: "电白区", Code: "440904", PostalCode: "525400"},
+					{Name: "高州市", Code: "440981", PostalCode: "525200"},
+					{Name: "化州市", Code: "440982", PostalCode: "525100"},
+					{Name: "信宜市", Code: "440983", PostalCode: "525300"},
+				},
+			},
+			{
+				Name: "肇庆市", Code: "441200", Population: 411, PhoneCode: "0758", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "端州区", Code: "441202", PostalCode: "526040"},
+					{Name: "鼎湖区", Code: "441203", PostalCode: "526070"},
+					{Name: "高要区", Code: "441204", PostalCode: "526100"},
+					{Name: "广宁县", Code: "441223", PostalCode: "526300"},
+					{Name: "怀集县", Code: "441224", PostalCode: "526400"},
+					{Name: "封开县", Code: "441225", PostalCode: "526500"},
+					{Name: "德庆县", Code: "441226", PostalCode: "526600"},
+					{Name: "四会市", Code: "441284", PostalCode: "526200"},
+				},
+			},
+			{
+				Name: "惠州市", Code: "441300", Population: 604, PhoneCode: "0752", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "惠城区", Code: "441302", PostalCode: "516000"},
+					{Name: "惠阳区", Code: "441303", PostalCode: "516200"},
+					{Name: "博罗县", Code: "441322", PostalCode: "516100"},
+					{Name: "惠东县", Code: "441323", PostalCode: "516300"},
+					{Name: "龙门县", Code: "441324", PostalCode: "516800"},
+				},
+			},
+			{
+				Name: "梅州市", Code: "441400", Population: 387, PhoneCode: "0753", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "梅江区", Code: "441402", PostalCode: "514000"},
+					{Name: "梅县区", Code: "441403", PostalCode: "514700"},
+					{Name: "大埔县", Code: "441422", PostalCode: "514200"},
+					{Name: "丰顺县", Code: "441423", PostalCode: "514300"},
+					{Name: "五华县", Code: "441424", PostalCode: "514400"},
+					{Name: "平远县", Code: "441426", PostalCode: "514600"},
+					{Name: "蕉岭县", Code: "441427", PostalCode: "514100"},
+					{Name: "兴宁市", Code: "441481", PostalCode: "514500"},
+				},
+			},
+			{
+				Name: "汕尾市", Code: "441500", Population: 267, PhoneCode: "0660", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城区", Code: "441502", PostalCode: "516600"},
+					{Name: "海丰县", Code: "441521", PostalCode: "516400"},
+					{Name: "陆河县", Code: "441523", PostalCode: "516700"},
+					{Name: "陆丰市", Code: "441581", PostalCode: "516500"},
+				},
+			},
+			{
+				Name: "河源市", Code: "441600", Population: 284, PhoneCode: "0762", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "源城区", Code: "441602", PostalCode: "517000"},
+					{Name: "紫金县", Code: "441621", PostalCode: "517400"},
+					{Name: "龙川县", Code: "441622", PostalCode: "517300"},
+					{Name: "连平县", Code: "441623", PostalCode: "517100"},
+					{Name: "和平县", Code: "441624", PostalCode: "517200"},
+					{Name: "东源县", Code: "441625", PostalCode: "517500"},
+				},
+			},
+			{
+				Name: "阳江市", Code: "441700", Population: 260, PhoneCode: "0662", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "江城区", Code: "441702", PostalCode: "529500"},
+					{Name: "阳东区", Code: "441704", PostalCode: "529900"},
+					{Name: "阳西县", Code: "441721", PostalCode: "529800"},
+					{Name: "阳春市", Code: "441781", PostalCode: "529600"},
+				},
+			},
+			{
+				Name: "清远市", Code: "441800", Population: 397, PhoneCode: "0763", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "清城区", Code: "441802", PostalCode: "511500"},
+					{Name: "清新区", Code: "441803", PostalCode: "511800"},
+					{Name: "佛冈县", Code: "441821", PostalCode: "511600"},
+					{Name: "阳山县", Code: "441823", PostalCode: "513100"},
+					{Name: "连山壮族瑶族自治县", Code: "441825", PostalCode: "513200"},
+					{Name: "连南瑶族自治县", Code: "441826", PostalCode: "513300"},
+					{Name: "英德市", Code: "441881", PostalCode: "513000"},
+					{Name: "连州市", Code: "441882", PostalCode: "513400"},
+				},
+			},
+			{
+				Name: "东莞市", Code: "441900", Population: 1047, PhoneCode: "0769", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "东莞市", Code: "441900", PostalCode: "523000"},
+				},
+			},
+			{
+				Name: "中山市", Code: "442000", Population: 442, PhoneCode: "0760", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "中山市", Code: "442000", PostalCode: "528400"},
+				},
+			},
+			{
+				Name: "潮州市", Code: "445100", Population: 257, PhoneCode: "0768", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "湘桥区", Code: "445102", PostalCode: "521000"},
+					{Name: "潮安区", Code: "445103", PostalCode: "515600"},
+					{Name: "饶平县", Code: "445122", PostalCode: "515700"},
+				},
+			},
+			{
+				Name: "揭阳市", Code: "445200", Population: 558, PhoneCode: "0663", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "榕城区", Code: "445202", PostalCode: "522000"},
+					{Name: "揭东区", Code: "445203", PostalCode: "515500"},
+					{Name: "揭西县", Code: "445222", PostalCode: "515400"},
+					{Name: "惠来县", Code: "445224", PostalCode: "515200"},
+					{Name: "普宁市", Code: "445281", PostalCode: "515300"},
+				},
+			},
+			{
+				Name: "云浮市", Code: "445300", Population: 238, PhoneCode: "0766", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "云城区", Code: "445302", PostalCode: "527300"},
+					{Name: "云安区", Code: "445303", PostalCode: "527500"},
+					{Name: "新兴县", Code: "445321", PostalCode: "527400"},
+					{Name: "郁南县", Code: "445322", PostalCode: "527100"},
+					{Name: "罗定市", Code: "445381", PostalCode: "527200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "广西壮族自治区", Short: "广西", Code: "45",
+		Name: "广西壮族自治区", Short: "广西", Abbr: "桂", Code: "45", Population: 5013,
 		Cities: []City{
-			{Name: "南宁市", AreaCodes: []string{"450100", "450102"}},
-			{Name: "柳州市", AreaCodes: []string{"450200"}},
-			{Name: "桂林市", AreaCodes: []string{"450300"}},
-			{Name: "梧州市", AreaCodes: []string{"450400"}},
-			{Name: "北海市", AreaCodes: []string{"450500"}},
+			{
+				Name: "南宁市", Code: "450100", Population: 874, PhoneCode: "0771", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "兴宁区", Code: "450102", PostalCode: "530000"},
+					{Name: "青秀区", Code: "450103", PostalCode: "530000"},
+					{Name: "江南区", Code: "450105", PostalCode: "530000"},
+					{Name: "西乡塘区", Code: "450107", PostalCode: "530000"},
+					{Name: "良庆区", Code: "450108", PostalCode: "530000"},
+					{Name: "邕宁区", Code: "450109", PostalCode: "530200"},
+					{Name: "武鸣区", Code: "450110", PostalCode: "530100", Since: 2015},
+					{Name: "隆安县", Code: "450123", PostalCode: "532700"},
+					{Name: "马山县", Code: "450124", PostalCode: "530600"},
+					{Name: "上林县", Code: "450125", PostalCode: "530500"},
+					{Name: "宾阳县", Code: "450126", PostalCode: "530400"},
+					{Name: "横州市", Code: "450181", PostalCode: "530300", Since: 2021},
+				},
+			},
+			{
+				Name: "柳州市", Code: "450200", Population: 416, PhoneCode: "0772", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城中区", Code: "450202", PostalCode: "545000"},
+					{Name: "鱼峰区", Code: "450203", PostalCode: "545000"},
+					{Name: "柳南区", Code: "450204", PostalCode: "545000"},
+					{Name: "柳北区", Code: "450205", PostalCode: "545000"},
+					{Name: "柳江区", Code: "450206", PostalCode: "545100"},
+					{Name: "柳城县", Code: "450222", PostalCode: "545200"},
+					{Name: "鹿寨县", Code: "450223", PostalCode: "545600"},
+					{Name: "融安县", Code: "450224", PostalCode: "545400"},
+					{Name: "融水苗族自治县", Code: "450225", PostalCode: "545300"},
+					{Name: "三江侗族自治县", Code: "450226", PostalCode: "545500"},
+				},
+			},
+			{
+				Name: "桂林市", Code: "450300", Population: 493, PhoneCode: "0773", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "秀峰区", Code: "450302", PostalCode: "541000"},
+					{Name: "叠彩区", Code: "450303", PostalCode: "541000"},
+					{Name: "象山区", Code: "450304", PostalCode: "541000"},
+					{Name: "七星区", Code: "450305", PostalCode: "541000"},
+					{Name: "雁山区", Code: "450311", PostalCode: "541006"},
+					{Name: "临桂区", Code: "450312", PostalCode: "541100"},
+					{Name: "阳朔县", Code: "450321", PostalCode: "541900"},
+					{Name: "灵川县", Code: "450323", PostalCode: "541200"},
+					{Name: "全州县", Code: "450324", PostalCode: "541500"},
+					{Name: "兴安县", Code: "450325", PostalCode: "541300"},
+					{Name: "永福县", Code: "450326", PostalCode: "541800"},
+					{Name: "灌阳县", Code: "450327", PostalCode: "541600"},
+					{Name: "龙胜各族自治县", Code: "450328", PostalCode: "541700"},
+					{Name: "资源县", Code: "450329", PostalCode: "541400"},
+					{Name: "平乐县", Code: "450330", PostalCode: "542400"},
+					{Name: "恭城瑶族自治县", Code: "450332", PostalCode: "542500"},
+					{Name: "荔浦市", Code: "450381", PostalCode: "546600"},
+				},
+			},
+			{
+				Name: "梧州市", Code: "450400", Population: 282, PhoneCode: "0774", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "万秀区", Code: "450403", PostalCode: "543000"},
+					{Name: "长洲区", Code: "450405", PostalCode: "543000"},
+					{Name: "龙圩区", Code: "450406", PostalCode: "543100"},
+					{Name: "苍梧县", Code: "450421", PostalCode: "543100"},
+					{Name: "藤县", Code: "450422", PostalCode: "543300"},
+					{Name: "蒙山县", Code: "450423", PostalCode: "546700"},
+					{Name: "岑溪市", Code: "450481", PostalCode: "543200"},
+				},
+			},
+			{
+				Name: "北海市", Code: "450500", Population: 185, PhoneCode: "0779", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "海城区", Code: "450502", PostalCode: "536000"},
+					{Name: "银海区", Code: "450503", PostalCode: "536000"},
+					{Name: "铁山港区", Code: "450512", PostalCode: "536017"},
+					{Name: "合浦县", Code: "450521", PostalCode: "536100"},
+				},
+			},
+			{
+				Name: "防城港市", Code: "450600", Population: 105, PhoneCode: "0770", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "港口区", Code: "450602", PostalCode: "538001"},
+					{Name: "防城区", Code: "450603", PostalCode: "538021"},
+					{Name: "上思县", Code: "450621", PostalCode: "535500"},
+					{Name: "东兴市", Code: "450681", PostalCode: "538100"},
+				},
+			},
+			{
+				Name: "钦州市", Code: "450700", Population: 330, PhoneCode: "0777", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "钦南区", Code: "450702", PostalCode: "535000"},
+					{Name: "钦北区", Code: "450703", PostalCode: "535000"},
+					{Name: "灵山县", Code: "450721", PostalCode: "535400"},
+					{Name: "浦北县", Code: "450722", PostalCode: "535300"},
+				},
+			},
+			{
+				Name: "贵港市", Code: "450800", Population: 432, PhoneCode: "0775", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "港北区", Code: "450802", PostalCode: "537100"},
+					{Name: "港南区", Code: "450803", PostalCode: "537100"},
+					{Name: "覃塘区", Code: "450804", PostalCode: "537100"},
+					{Name: "平南县", Code: "450821", PostalCode: "537300"},
+					{Name: "桂平市", Code: "450881", PostalCode: "537200"},
+				},
+			},
+			{
+				Name: "玉林市", Code: "450900", Population: 580, PhoneCode: "0775", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "玉州区", Code: "450902", PostalCode: "537000"},
+					{Name: "福绵区", Code: "450903", PostalCode: "537023"},
+					{Name: "容县", Code: "450921", PostalCode: "537500"},
+					{Name: "陆川县", Code: "450922", PostalCode: "537700"},
+					{Name: "博白县", Code: "450923", PostalCode: "537600"},
+					{Name: "兴业县", Code: "450924", PostalCode: "537800"},
+					{Name: "北流市", Code: "450981", PostalCode: "537400"},
+				},
+			},
+			{
+				Name: "百色市", Code: "451000", Population: 357, PhoneCode: "0776", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "右江区", Code: "451002", PostalCode: "533000"},
+					{Name: "田阳区", Code: "451003", PostalCode: "533600"},
+					{Name: "田东县", Code: "451022", PostalCode: "531500"},
+					{Name: "德保县", Code: "451024", PostalCode: "533700"},
+					{Name: "那坡县", Code: "451026", PostalCode: "533900"},
+					{Name: "凌云县", Code: "451027", PostalCode: "533100"},
+					{Name: "乐业县", Code: "451028", PostalCode: "533200"},
+					{Name: "田林县", Code: "451029", PostalCode: "533300"},
+					{Name: "西林县", Code: "451030", PostalCode: "533500"},
+					{Name: "隆林各族自治县", Code: "451031", PostalCode: "533400"},
+					{Name: "靖西市", Code: "451081", PostalCode: "533800"},
+					{Name: "平果市", Code: "451082", PostalCode: "531400"},
+				},
+			},
+			{
+				Name: "贺州市", Code: "451100", Population: 201, PhoneCode: "0774", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "八步区", Code: "451102", PostalCode: "542800"},
+					{Name: "平桂区", Code: "451103", PostalCode: "542800"},
+					{Name: "昭平县", Code: "451121", PostalCode: "546800"},
+					{Name: "钟山县", Code: "451122", PostalCode: "542600"},
+					{Name: "富川瑶族自治县", Code: "451123", PostalCode: "542700"},
+				},
+			},
+			{
+				Name: "河池市", Code: "451200", Population: 342, PhoneCode: "0778", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "金城江区", Code: "451202", PostalCode: "547000"},
+					{Name: "宜州区", Code: "451203", PostalCode: "546300"},
+					{Name: "南丹县", Code: "451221", PostalCode: "547200"},
+					{Name: "天峨县", Code: "451222", PostalCode: "547300"},
+					{Name: "凤山县", Code: "451223", PostalCode: "547600"},
+					{Name: "东兰县", Code: "451224", PostalCode: "547400"},
+					{Name: "罗城仫佬族自治县", Code: "451225", PostalCode: "546400"},
+					{Name: "环江毛南族自治县", Code: "451226", PostalCode: "547100"},
+					{Name: "巴马瑶族自治县", Code: "451227", PostalCode: "547500"},
+					{Name: "都安瑶族自治县", Code: "451228", PostalCode: "530700"},
+					{Name: "大化瑶族自治县", Code: "451229", PostalCode: "530800"},
+				},
+			},
+			{
+				Name: "来宾市", Code: "451300", Population: 207, PhoneCode: "0772", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "兴宾区", Code: "451302", PostalCode: "546100"},
+					{Name: "忻城县", Code: "451321", PostalCode: "546200"},
+					{Name: "象州县", Code: "451322", PostalCode: "545800"},
+					{Name: "武宣县", Code: "451323", PostalCode: "545900"},
+					{Name: "金秀瑶族自治县", Code: "451324", PostalCode: "545700"},
+					{Name: "合山市", Code: "451381", PostalCode: "546500"},
+				},
+			},
+			{
+				Name: "崇左市", Code: "451400", Population: 209, PhoneCode: "0771", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "江州区", Code: "451402", PostalCode: "532200"},
+					{Name: "扶绥县", Code: "451421", PostalCode: "532100"},
+					{Name: "宁明县", Code: "451422", PostalCode: "532500"},
+					{Name: "龙州县", Code: "451423", PostalCode: "532400"},
+					{Name: "大新县", Code: "451424", PostalCode: "532300"},
+					{Name: "天等县", Code: "451425", PostalCode: "532800"},
+					{Name: "凭祥市", Code: "451481", PostalCode: "532600"},
+				},
+			},
 		},
 	},
 	{
-		Name: "海南省", Short: "海南", Code: "46",
+		Name: "海南省", Short: "海南", Abbr: "琼", Code: "46", Population: 1008,
 		Cities: []City{
-			{Name: "海口市", AreaCodes: []string{"460100", "460105"}},
-			{Name: "三亚市", AreaCodes: []string{"460200"}},
-			{Name: "三沙市", AreaCodes: []string{"460300"}},
-			{Name: "儋州市", AreaCodes: []string{"460400"}},
+			{
+				Name: "海口市", Code: "460100", Population: 287, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "秀英区", Code: "460105", PostalCode: "570311"},
+					{Name: "龙华区", Code: "460106", PostalCode: "570105"},
+					{Name: "琼山区", Code: "460107", PostalCode: "571100"},
+					{Name: "美兰区", Code: "460108", PostalCode: "570203"},
+				},
+			},
+			{
+				Name: "三亚市", Code: "460200", Population: 103, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "海棠区", Code: "460202", PostalCode: "572000"},
+					{Name: "吉阳区", Code: "460203", PostalCode: "572000"},
+					{Name: "天涯区", Code: "460204", PostalCode: "572000"},
+					{Name: "崖州区", Code: "460205", PostalCode: "572000"},
+				},
+			},
+			{
+				Name: "三沙市", Code: "460300", Population: 0, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "西沙区", Code: "460301", PostalCode: "573100"},
+					{Name: "南沙区", Code: "460302", PostalCode: "573100"},
+				},
+			},
+			{
+				Name: "儋州市", Code: "460400", Population: 95, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "儋州市", Code: "460400", PostalCode: "571700", Since: 2015},
+				},
+			},
+			{
+				Name: "五指山市", Code: "469001", Population: 11, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "五指山市", Code: "469001", PostalCode: "572200"},
+				},
+			},
+			{
+				Name: "琼海市", Code: "469002", Population: 53, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "琼海市", Code: "469002", PostalCode: "571400"},
+				},
+			},
+			{
+				Name: "文昌市", Code: "469005", Population: 56, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "文昌市", Code: "469005", PostalCode: "571300"},
+				},
+			},
+			{
+				Name: "万宁市", Code: "469006", Population: 55, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "万宁市", Code: "469006", PostalCode: "571500"},
+				},
+			},
+			{
+				Name: "东方市", Code: "469007", Population: 44, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "东方市", Code: "469007", PostalCode: "572600"},
+				},
+			},
+			{
+				Name: "定安县", Code: "469021", Population: 28, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "定安县", Code: "469021", PostalCode: "571200"},
+				},
+			},
+			{
+				Name: "屯昌县", Code: "469022", Population: 25, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "屯昌县", Code: "469022", PostalCode: "571600"},
+				},
+			},
+			{
+				Name: "澄迈县", Code: "469023", Population: 50, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "澄迈县", Code: "469023", PostalCode: "571900"},
+				},
+			},
+			{
+				Name: "临高县", Code: "469024", Population: 42, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "临高县", Code: "469024", PostalCode: "571800"},
+				},
+			},
+			{
+				Name: "白沙黎族自治县", Code: "469025", Population: 17, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "白沙黎族自治县", Code: "469025", PostalCode: "572800"},
+				},
+			},
+			{
+				Name: "昌江黎族自治县", Code: "469026", Population: 23, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "昌江黎族自治县", Code: "469026", PostalCode: "572700"},
+				},
+			},
+			{
+				Name: "乐东黎族自治县", Code: "469027", Population: 46, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "乐东黎族自治县", Code: "469027", PostalCode: "572500"},
+				},
+			},
+			{
+				Name: "陵水黎族自治县", Code: "469028", Population: 37, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "陵水黎族自治县", Code: "469028", PostalCode: "572400"},
+				},
+			},
+			{
+				Name: "保亭黎族苗族自治县", Code: "469029", Population: 15, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "保亭黎族苗族自治县", Code: "469029", PostalCode: "572300"},
+				},
+			},
+			{
+				Name: "琼中黎族苗族自治县", Code: "469030", Population: 17, PhoneCode: "0898", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "琼中黎族苗族自治县", Code: "469030", PostalCode: "572900"},
+				},
+			},
 		},
 	},
 	{
-		Name: "重庆市", Short: "重庆", Code: "50",
+		Name: "重庆市", Short: "重庆", Abbr: "渝", Code: "50", Population: 3205,
 		Cities: []City{
-			{Name: "渝中区", AreaCodes: []string{"500101"}},
-			{Name: "江北区", AreaCodes: []string{"500105"}},
-			{Name: "沙坪坝区", AreaCodes: []string{"500106"}},
-			{Name: "九龙坡区", AreaCodes: []string{"500107"}},
-			{Name: "南岸区", AreaCodes: []string{"500108"}},
-			{Name: "渝北区", AreaCodes: []string{"500112"}},
-			{Name: "巴南区", AreaCodes: []string{"500113"}},
+			{
+				Name: "重庆市", Code: "500100", Population: 3205, PhoneCode: "023", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "万州区", Code: "500101", PostalCode: "404000"},
+					{Name: "涪陵区", Code: "500102", PostalCode: "408000"},
+					{Name: "渝中区", Code: "500103", PostalCode: "400010", Since: 1997},
+					{Name: "大渡口区", Code: "500104", PostalCode: "400080", Since: 1997},
+					{Name: "江北区", Code: "500105", PostalCode: "400020", Since: 1997},
+					{Name: "沙坪坝区", Code: "500106", PostalCode: "400030", Since: 1997},
+					{Name: "九龙坡区", Code: "500107", PostalCode: "400050", Since: 1997},
+					{Name: "南岸区", Code: "500108", PostalCode: "400060", Since: 1997},
+					{Name: "北碚区", Code: "500109", PostalCode: "400700", Since: 1997},
+					{Name: "綦江区", Code: "500110", PostalCode: "401420"},
+					{Name: "大足区", Code: "500111", PostalCode: "402360"},
+					{Name: "渝北区", Code: "500112", PostalCode: "401120"},
+					{Name: "巴南区", Code: "500113", PostalCode: "401320"},
+					{Name: "黔江区", Code: "500114", PostalCode: "409000"},
+					{Name: "长寿区", Code: "500115", PostalCode: "401220", Since: 2001},
+					{Name: "江津区", Code: "500116", PostalCode: "402260", Since: 2006},
+					{Name: "合川区", Code: "500117", PostalCode: "401520", Since: 2006},
+					{Name: "永川区", Code: "500118", PostalCode: "402160", Since: 2006},
+					{Name: "南川区", Code: "500119", PostalCode: "408400", Since: 2006},
+					{Name: "璧山区", Code: "500120", PostalCode: "402760", Since: 2014},
+					{Name: "铜梁区", Code: "500151", PostalCode: "402560", Since: 2014},
+					{Name: "潼南区", Code: "500152", PostalCode: "402660", Since: 2015},
+					{Name: "荣昌区", Code: "500153", PostalCode: "402460", Since: 2015},
+					{Name: "开州区", Code: "500154", PostalCode: "405400", Since: 2016},
+					{Name: "梁平区", Code: "500155", PostalCode: "405200", Since: 2016},
+					{Name: "武隆区", Code: "500156", PostalCode: "408500", Since: 2016},
+					{Name: "城口县", Code: "500229", PostalCode: "405900"},
+					{Name: "丰都县", Code: "500230", PostalCode: "408200"},
+					{Name: "垫江县", Code: "500231", PostalCode: "408300"},
+					{Name: "忠县", Code: "500233", PostalCode: "404300"},
+					{Name: "云阳县", Code: "500235", PostalCode: "404500"},
+					{Name: "奉节县", Code: "500236", PostalCode: "404600"},
+					{Name: "巫山县", Code: "500237", PostalCode: "404700"},
+					{Name: "巫溪县", Code: "500238", PostalCode: "405800"},
+					{Name: "石柱土家族自治县", Code: "500240", PostalCode: "409100"},
+					{Name: "秀山土家族苗族自治县", Code: "500241", PostalCode: "409900"},
+					{Name: "酉阳土家族苗族自治县", Code: "500242", PostalCode: "409800"},
+					{Name: "彭水苗族土家族自治县", Code: "500243", PostalCode: "409600"},
+				},
+			},
 		},
 	},
 	{
-		Name: "四川省", Short: "四川", Code: "51",
+		Name: "四川省", Short: "四川", Abbr: "川", Code: "51", Population: 8367,
 		Cities: []City{
-			{Name: "成都市", AreaCodes: []string{"510100", "510104", "510105"}},
-			{Name: "自贡市", AreaCodes: []string{"510300"}},
-			{Name: "攀枝花市", AreaCodes: []string{"510400"}},
-			{Name: "泸州市", AreaCodes: []string{"510500"}},
-			{Name: "德阳市", AreaCodes: []string{"510600"}},
-			{Name: "绵阳市", AreaCodes: []string{"510700"}},
+			{
+				Name: "成都市", Code: "510100", Population: 2094, PhoneCode: "028", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "锦江区", Code: "510104", PostalCode: "610021"},
+					{Name: "青羊区", Code: "510105", PostalCode: "610031"},
+					{Name: "金牛区", Code: "510106", PostalCode: "610036"},
+					{Name: "武侯区", Code: "510107", PostalCode: "610041"},
+					{Name: "成华区", Code: "510108", PostalCode: "610066"},
+					{Name: "龙泉驿区", Code: "510112", PostalCode: "610100"},
+					{Name: "青白江区", Code: "510113", PostalCode: "610300"},
+					{Name: "新都区", Code: "510114", PostalCode: "610500"},
+					{Name: "温江区", Code: "510115", PostalCode: "611130"},
+					{Name: "双流区", Code: "510116", PostalCode: "610200", Since: 2015},
+					{Name: "郫都区", Code: "510117", PostalCode: "611730", Since: 2016},
+					{Name: "新津区", Code: "510118", PostalCode: "611430", Since: 2020},
+					{Name: "金堂县", Code: "510121", PostalCode: "610400"},
+					{Name: "大邑县", Code: "510129", PostalCode: "611300"},
+					{Name: "蒲江县", Code: "510131", PostalCode: "611630"},
+					{Name: "都江堰市", Code: "510181", PostalCode: "611830"},
+					{Name: "彭州市", Code: "510182", PostalCode: "611930"},
+					{Name: "邛崃市", Code: "510183", PostalCode: "611530"},
+					{Name: "崇州市", Code: "510184", PostalCode: "611230"},
+					{Name: "简阳市", Code: "510185", PostalCode: "641400", Since: 2016},
+				},
+			},
+			{
+				Name: "自贡市", Code: "510300", Population: 249, PhoneCode: "0813", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "自流井区", Code: "510302", PostalCode: "643000"},
+					{Name: "贡井区", Code: "510303", PostalCode: "643000"},
+					{Name: "大安区", Code: "510304", PostalCode: "643000"},
+					{Name: "沿滩区", Code: "510311", PostalCode: "643000"},
+					{Name: "荣县", Code: "510321", PostalCode: "643100"},
+					{Name: "富顺县", Code: "510322", PostalCode: "643200"},
+				},
+			},
+			{
+				Name: "攀枝花市", Code: "510400", Population: 121, PhoneCode: "0812", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "东区", Code: "510402", PostalCode: "617000"},
+					{Name: "西区", Code: "510403", PostalCode: "617000"},
+					{Name: "仁和区", Code: "510411", PostalCode: "617000"},
+					{Name: "米易县", Code: "510421", PostalCode: "617200"},
+					{Name: "盐边县", Code: "510422", PostalCode: "617100"},
+				},
+			},
+			{
+				Name: "泸州市", Code: "510500", Population: 425, PhoneCode: "0830", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "江阳区", Code: "510502", PostalCode: "646000"},
+					{Name: "纳溪区", Code: "510503", PostalCode: "646300"},
+					{Name: "龙马潭区", Code: "510504", PostalCode: "646000"},
+					{Name: "泸县", Code: "510521", PostalCode: "646100"},
+					{Name: "合江县", Code: "510522", PostalCode: "646200"},
+					{Name: "叙永县", Code: "510524", PostalCode: "646400"},
+					{Name: "古蔺县", Code: "510525", PostalCode: "646500"},
+				},
+			},
+			{
+				Name: "德阳市", Code: "510600", Population: 346, PhoneCode: "0838", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "旌阳区", Code: "510603", PostalCode: "618000"},
+					{Name: "罗江区", Code: "510604", PostalCode: "618500"},
+					{Name: "中江县", Code: "510623", PostalCode: "618100"},
+					{Name: "广汉市", Code: "510681", PostalCode: "618300"},
+					{Name: "什邡市", Code: "510682", PostalCode: "618400"},
+					{Name: "绵竹市", Code: "510683", PostalCode: "618200"},
+				},
+			},
+			{
+				Name: "绵阳市", Code: "510700", Population: 487, PhoneCode: "0816", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "涪城区", Code: "510703", PostalCode: "621000"},
+					{Name: "游仙区", Code: "510704", PostalCode: "621000"},
+					{Name: "安州区", Code: "510705", PostalCode: "622650"},
+					{Name: "三台县", Code: "510722", PostalCode: "621100"},
+					{Name: "盐亭县", Code: "510723", PostalCode: "621600"},
+					{Name: "梓潼县", Code: "510725", PostalCode: "622150"},
+					{Name: "北川羌族自治县", Code: "510726", PostalCode: "622750"},
+					{Name: "平武县", Code: "510727", PostalCode: "622550"},
+					{Name: "江油市", Code: "510781", PostalCode: "621700"},
+				},
+			},
+			{
+				Name: "广元市", Code: "510800", Population: 231, PhoneCode: "0839", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "利州区", Code: "510802", PostalCode: "628017"},
+					{Name: "昭化区", Code: "510811", PostalCode: "628017"},
+					{Name: "朝天区", Code: "510812", PostalCode: "628017"},
+					{Name: "旺苍县", Code: "510821", PostalCode: "628200"},
+					{Name: "青川县", Code: "510822", PostalCode: "628100"},
+					{Name: "剑阁县", Code: "510823", PostalCode: "628300"},
+					{Name: "苍溪县", Code: "510824", PostalCode: "628400"},
+				},
+			},
+			{
+				Name: "遂宁市", Code: "510900", Population: 281, PhoneCode: "0825", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "船山区", Code: "510903", PostalCode: "629000"},
+					{Name: "安居区", Code: "510904", PostalCode: "629000"},
+					{Name: "蓬溪县", Code: "510921", PostalCode: "629100"},
+					{Name: "大英县", Code: "510923", PostalCode: "629300"},
+					{Name: "射洪市", Code: "510981", PostalCode: "629200"},
+				},
+			},
+			{
+				Name: "内江市", Code: "511000", Population: 314, PhoneCode: "0832", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "市中区", Code: "511002", PostalCode: "641000"},
+					{Name: "东兴区", Code: "511011", PostalCode: "641000"},
+					{Name: "威远县", Code: "511024", PostalCode: "642450"},
+					{Name: "资中县", Code: "511025", PostalCode: "641200"},
+					{Name: "隆昌市", Code: "511083", PostalCode: "642150"},
+				},
+			},
+			{
+				Name: "乐山市", Code: "511100", Population: 316, PhoneCode: "0833", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "市中区", Code: "511102", PostalCode: "614000"},
+					{Name: "沙湾区", Code: "511111", PostalCode: "614900"},
+					{Name: "五通桥区", Code: "511112", PostalCode: "614800"},
+					{Name: "金口河区", Code: "511113", PostalCode: "614700"},
+					{Name: "犍为县", Code: "511123", PostalCode: "614400"},
+					{Name: "井研县", Code: "511124", PostalCode: "613100"},
+					{Name: "夹江县", Code: "511126", PostalCode: "614100"},
+					{Name: "沐川县", Code: "511129", PostalCode: "614500"},
+					{Name: "峨边彝族自治县", Code: "511132", PostalCode: "614300"},
+					{Name: "马边彝族自治县", Code: "511133", PostalCode: "614600"},
+					{Name: "峨眉山市", Code: "511181", PostalCode: "614200"},
+				},
+			},
+			{
+				Name: "南充市", Code: "511300", Population: 561, PhoneCode: "0817", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "顺庆区", Code: "511302", PostalCode: "637000"},
+					{Name: "高坪区", Code: "511303", PostalCode: "637000"},
+					{Name: "嘉陵区", Code: "511304", PostalCode: "637000"},
+					{Name: "南部县", Code: "511321", PostalCode: "637300"},
+					{Name: "营山县", Code: "511322", PostalCode: "637700"},
+					{Name: "蓬安县", Code: "511323", PostalCode: "637800"},
+					{Name: "仪陇县", Code: "511324", PostalCode: "637600"},
+					{Name: "西充县", Code: "511325", PostalCode: "637200"},
+					{Name: "阆中市", Code: "511381", PostalCode: "637400"},
+				},
+			},
+			{
+				Name: "眉山市", Code: "511400", Population: 296, PhoneCode: "028", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "东坡区", Code: "511402", PostalCode: "620010"},
+					{Name: "彭山区", Code: "511403", PostalCode: "620860"},
+					{Name: "仁寿县", Code: "511421", PostalCode: "620500"},
+					{Name: "洪雅县", Code: "511423", PostalCode: "620300"},
+					{Name: "丹棱县", Code: "511424", PostalCode: "620200"},
+					{Name: "青神县", Code: "511425", PostalCode: "620400"},
+				},
+			},
+			{
+				Name: "宜宾市", Code: "511500", Population: 459, PhoneCode: "0831", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "翠屏区", Code: "511502", PostalCode: "644000"},
+					{Name: "南溪区", Code: "511503", PostalCode: "644100"},
+					{Name: "叙州区", Code: "511504", PostalCode: "644600"},
+					{Name: "江安县", Code: "511523", PostalCode: "644200"},
+					{Name: "长宁县", Code: "511524", PostalCode: "644300"},
+					{Name: "高县", Code: "511525", PostalCode: "645150"},
+					{Name: "珙县", Code: "511526", PostalCode: "644500"},
+					{Name: "筠连县", Code: "511527", PostalCode: "645250"},
+					{Name: "兴文县", Code: "511528", PostalCode: "644400"},
+					{Name: "屏山县", Code: "511529", PostalCode: "645350"},
+				},
+			},
+			{
+				Name: "广安市", Code: "511600", Population: 325, PhoneCode: "0826", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "广安区", Code: "511602", PostalCode: "638000"},
+					{Name: "前锋区", Code: "511603", PostalCode: "638019"},
+					{Name: "岳池县", Code: "511621", PostalCode: "638300"},
+					{Name: "武胜县", Code: "511622", PostalCode: "638400"},
+					{Name: "邻水县", Code: "511623", PostalCode: "638500"},
+					{Name: "华蓥市", Code: "511681", PostalCode: "638600"},
+				},
+			},
+			{
+				Name: "达州市", Code: "511700", Population: 539, PhoneCode: "0818", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "通川区", Code: "511702", PostalCode: "635000"},
+					{Name: "达川区", Code: "511703", PostalCode: "635000"},
+					{Name: "宣汉县", Code: "511722", PostalCode: "636150"},
+					{Name: "开江县", Code: "511723", PostalCode: "636250"},
+					{Name: "大竹县", Code: "511724", PostalCode: "635100"},
+					{Name: "渠县", Code: "511725", PostalCode: "635200"},
+					{Name: "万源市", Code: "511781", PostalCode: "636350"},
+				},
+			},
+			{
+				Name: "雅安市", Code: "511800", Population: 143, PhoneCode: "0835", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "雨城区", Code: "511802", PostalCode: "625000"},
+					{Name: "名山区", Code: "511803", PostalCode: "625100"},
+					{Name: "荥经县", Code: "511822", PostalCode: "625200"},
+					{Name: "汉源县", Code: "511823", PostalCode: "625300"},
+					{Name: "石棉县", Code: "511824", PostalCode: "625400"},
+					{Name: "天全县", Code: "511825", PostalCode: "625500"},
+					{Name: "芦山县", Code: "511826", PostalCode: "625600"},
+					{Name: "宝兴县", Code: "511827", PostalCode: "625700"},
+				},
+			},
+			{
+				Name: "巴中市", Code: "511900", Population: 271, PhoneCode: "0827", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "巴州区", Code: "511902", PostalCode: "636001"},
+					{Name: "恩阳区", Code: "511903", PostalCode: "636064"},
+					{Name: "通江县", Code: "511921", PostalCode: "636700"},
+					{Name: "南江县", Code: "511922", PostalCode: "636600"},
+					{Name: "平昌县", Code: "511923", PostalCode: "636400"},
+				},
+			},
+			{
+				Name: "资阳市", Code: "512000", Population: 231, PhoneCode: "028", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "雁江区", Code: "512002", PostalCode: "641300"},
+					{Name: "安岳县", Code: "512021", PostalCode: "642350"},
+					{Name: "乐至县", Code: "512022", PostalCode: "641500"},
+				},
+			},
+			{
+				Name: "阿坝藏族羌族自治州", Code: "513200", Population: 82, PhoneCode: "0837", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "马尔康市", Code: "513201", PostalCode: "624000"},
+					{Name: "汶川县", Code: "513221", PostalCode: "623000"},
+					{Name: "理县", Code: "513222", PostalCode: "623100"},
+					{Name: "茂县", Code: "513223", PostalCode: "623200"},
+					{Name: "松潘县", Code: "513224", PostalCode: "623300"},
+					{Name: "九寨沟县", Code: "513225", PostalCode: "623400"},
+					{Name: "金川县", Code: "513226", PostalCode: "624100"},
+					{Name: "小金县", Code: "513227", PostalCode: "624200"},
+					{Name: "黑水县", Code: "513228", PostalCode: "623500"},
+					{Name: "壤塘县", Code: "513230", PostalCode: "624300"},
+					{Name: "阿坝县", Code: "513231", PostalCode: "624600"},
+					{Name: "若尔盖县", Code: "513232", PostalCode: "624500"},
+					{Name: "红原县", Code: "513233", PostalCode: "624400"},
+				},
+			},
+			{
+				Name: "甘孜藏族自治州", Code: "513300", Population: 111, PhoneCode: "0836", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "康定市", Code: "513301", PostalCode: "626000"},
+					{Name: "泸定县", Code: "513322", PostalCode: "626100"},
+					{Name: "丹巴县", Code: "513323", PostalCode: "626300"},
+					{Name: "九龙县", Code: "513324", PostalCode: "626200"},
+					{Name: "雅江县", Code: "513325", PostalCode: "627450"},
+					{Name: "道孚县", Code: "513326", PostalCode: "626400"},
+					{Name: "炉霍县", Code: "513327", PostalCode: "626500"},
+					{Name: "甘孜县", Code: "513328", PostalCode: "626700"},
+					{Name: "新龙县", Code: "513329", PostalCode: "626800"},
+					{Name: "德格县", Code: "513330", PostalCode: "627250"},
+					{Name: "白玉县", Code: "513331", PostalCode: "627150"},
+					{Name: "石渠县", Code: "513332", PostalCode: "627350"},
+					{Name: "色达县", Code: "513333", PostalCode: "626600"},
+					{Name: "理塘县", Code: "513334", PostalCode: "627550"},
+					{Name: "巴塘县", Code: "513335", PostalCode: "627650"},
+					{Name: "乡城县", Code: "513336", PostalCode: "627850"},
+					{Name: "稻城县", Code: "513337", PostalCode: "627750"},
+					{Name: "得荣县", Code: "513338", PostalCode: "627950"},
+				},
+			},
+			{
+				Name: "凉山彝族自治州", Code: "513400", Population: 486, PhoneCode: "0834", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "西昌市", Code: "513401", PostalCode: "615000"},
+					{Name: "会理市", Code: "513402", PostalCode: "615100"},
+					{Name: "木里藏族自治县", Code: "513422", PostalCode: "615800"},
+					{Name: "盐源县", Code: "513423", PostalCode: "615700"},
+					{Name: "德昌县", Code: "513424", PostalCode: "615500"},
+					{Name: "会东县", Code: "513426", PostalCode: "615200"},
+					{Name: "宁南县", Code: "513427", PostalCode: "615400"},
+					{Name: "普格县", Code: "513428", PostalCode: "615300"},
+					{Name: "布拖县", Code: "513429", PostalCode: "616350"},
+					{Name: "金阳县", Code: "513430", PostalCode: "616250"},
+					{Name: "昭觉县", Code: "513431", PostalCode: "616150"},
+					{Name: "喜德县", Code: "513432", PostalCode: "616750"},
+					{Name: "冕宁县", Code: "513433", PostalCode: "615600"},
+					{Name: "越西县", Code: "513434", PostalCode: "616650"},
+					{Name: "甘洛县", Code: "513435", PostalCode: "616850"},
+					{Name: "美姑县", Code: "513436", PostalCode: "616450"},
+					{Name: "雷波县", Code: "513437", PostalCode: "616550"},
+				},
+			},
 		},
 	},
 	{
-		Name: "贵州省", Short: "贵州", Code: "52",
+		Name: "贵州省", Short: "贵州", Abbr: "黔", Code: "52", Population: 3856,
 		Cities: []City{
-			{Name: "贵阳市", AreaCodes: []string{"520100", "520102"}},
-			{Name: "六盘水市", AreaCodes: []string{"520200"}},
-			{Name: "遵义市", AreaCodes: []string{"520300"}},
-			{Name: "安顺市", AreaCodes: []string{"520400"}},
+			{
+				Name: "贵阳市", Code: "520100", Population: 599, PhoneCode: "0851", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "南明区", Code: "520102", PostalCode: "550000"},
+					{Name: "云岩区", Code: "520103", PostalCode: "550000"},
+					{Name: "花溪区", Code: "520111", PostalCode: "550025"},
+					{Name: "乌当区", Code: "520112", PostalCode: "550018"},
+					{Name: "白云区", Code: "520113", PostalCode: "550014"},
+					{Name: "观山湖区", Code: "520115", PostalCode: "550081"},
+					{Name: "开阳县", Code: "520121", PostalCode: "550300"},
+					{Name: "息烽县", Code: "520122", PostalCode: "551100"},
+					{Name: "修文县", Code: "520123", PostalCode: "550200"},
+					{Name: "清镇市", Code: "520181", PostalCode: "551400"},
+				},
+			},
+			{
+				Name: "六盘水市", Code: "520200", Population: 303, PhoneCode: "0858", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "钟山区", Code: "520201", PostalCode: "553000"},
+					{Name: "六枝特区", Code: "520203", PostalCode: "553400"},
+					{Name: "水城区", Code: "520204", PostalCode: "553600", Since: 2020},
+					{Name: "盘州市", Code: "520281", PostalCode: "553500", Since: 2017},
+				},
+			},
+			{
+				Name: "遵义市", Code: "520300", Population: 661, PhoneCode: "0851", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "红花岗区", Code: "520302", PostalCode: "563000"},
+					{Name: "汇川区", Code: "520303", PostalCode: "563000"},
+					{Name: "播州区", Code: "520304", PostalCode: "563100"},
+					{Name: "桐梓县", Code: "520322", PostalCode: "563200"},
+					{Name: "绥阳县", Code: "520323", PostalCode: "563300"},
+					{Name: "正安县", Code: "520324", PostalCode: "563400"},
+					{Name: "道真仡佬族苗族自治县", Code: "520325", PostalCode: "563500"},
+					{Name: "务川仡佬族苗族自治县", Code: "520326", PostalCode: "564300"},
+					{Name: "凤冈县", Code: "520327", PostalCode: "564200"},
+					{Name: "湄潭县", Code: "520328", PostalCode: "564100"},
+					{Name: "余庆县", Code: "520329", PostalCode: "564400"},
+					{Name: "习水县", Code: "520330", PostalCode: "564600"},
+					{Name: "赤水市", Code: "520381", PostalCode: "564700"},
+					{Name: "仁怀市", Code: "520382", PostalCode: "564500"},
+				},
+			},
+			{
+				Name: "安顺市", Code: "520400", Population: 247, PhoneCode: "0851", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "西秀区", Code: "520402", PostalCode: "561000"},
+					{Name: "平坝区", Code: "520403", PostalCode: "561100"},
+					{Name: "普定县", Code: "520422", PostalCode: "562100"},
+					{Name: "镇宁布依族苗族自治县", Code: "520423", PostalCode: "561200"},
+					{Name: "关岭布依族苗族自治县", Code: "520424", PostalCode: "561300"},
+					{Name: "紫云苗族布依族自治县", Code: "520425", PostalCode: "550800"},
+				},
+			},
+			{
+				Name: "毕节市", Code: "520500", Population: 690, PhoneCode: "0857", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "七星关区", Code: "520502", PostalCode: "551700"},
+					{Name: "大方县", Code: "520521", PostalCode: "551600"},
+					{Name: "金沙县", Code: "520523", PostalCode: "551800"},
+					{Name: "织金县", Code: "520524", PostalCode: "552100"},
+					{Name: "纳雍县", Code: "520525", PostalCode: "553300"},
+					{Name: "威宁彝族回族苗族自治县", Code: "520526", PostalCode: "553100"},
+					{Name: "赫章县", Code: "520527", PostalCode: "553200"},
+					{Name: "黔西市", Code: "520581", PostalCode: "551500", Since: 2021},
+				},
+			},
+			{
+				Name: "铜仁市", Code: "520600", Population: 330, PhoneCode: "0856", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "碧江区", Code: "520602", PostalCode: "554300"},
+					{Name: "万山区", Code: "520603", PostalCode: "554200"},
+					{Name: "江口县", Code: "520621", PostalCode: "554400"},
+					{Name: "玉屏侗族自治县", Code: "520622", PostalCode: "554004"},
+					{Name: "石阡县", Code: "520623", PostalCode: "555100"},
+					{Name: "思南县", Code: "520624", PostalCode: "565100"},
+					{Name: "印江土家族苗族自治县", Code: "520625", PostalCode: "555200"},
+					{Name: "德江县", Code: "520626", PostalCode: "565200"},
+					{Name: "沿河土家族自治县", Code: "520627", PostalCode: "565300"},
+					{Name: "松桃苗族自治县", Code: "520628", PostalCode: "554100"},
+				},
+			},
+			{
+				Name: "黔西南布依族苗族自治州", Code: "522300", Population: 305, PhoneCode: "0859", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "兴义市", Code: "522301", PostalCode: "562400"},
+					{Name: "兴仁市", Code: "522302", PostalCode: "562300"},
+					{Name: "普安县", Code: "522323", PostalCode: "561500"},
+					{Name: "晴隆县", Code: "522324", PostalCode: "561400"},
+					{Name: "贞丰县", Code: "522325", PostalCode: "562200"},
+					{Name: "望谟县", Code: "522326", PostalCode: "552300"},
+					{Name: "册亨县", Code: "522327", PostalCode: "552200"},
+					{Name: "安龙县", Code: "522328", PostalCode: "552400"},
+				},
+			},
+			{
+				Name: "黔东南苗族侗族自治州", Code: "522600", Population: 376, PhoneCode: "0855", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "凯里市", Code: "522601", PostalCode: "556000"},
+					{Name: "黄平县", Code: "522622", PostalCode: "556100"},
+					{Name: "施秉县", Code: "522623", PostalCode: "556200"},
+					{Name: "三穗县", Code: "522624", PostalCode: "556500"},
+					{Name: "镇远县", Code: "522625", PostalCode: "557700"},
+					{Name: "岑巩县", Code: "522626", PostalCode: "557800"},
+					{Name: "天柱县", Code: "522627", PostalCode: "556600"},
+					{Name: "锦屏县", Code: "522628", PostalCode: "556700"},
+					{Name: "剑河县", Code: "522629", PostalCode: "556400"},
+					{Name: "台江县", Code: "522630", PostalCode: "556300"},
+					{Name: "黎平县", Code: "522631", PostalCode: "557300"},
+					{Name: "榕江县", Code: "522632", PostalCode: "557200"},
+					{Name: "从江县", Code: "522633", PostalCode: "557400"},
+					{Name: "雷山县", Code: "522634", PostalCode: "557100"},
+					{Name: "麻江县", Code: "522635", PostalCode: "557600"},
+					{Name: "丹寨县", Code: "522636", PostalCode: "557500"},
+				},
+			},
+			{
+				Name: "黔南布依族苗族自治州", Code: "522700", Population: 349, PhoneCode: "0854", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "都匀市", Code: "522701", PostalCode: "558000"},
+					{Name: "福泉市", Code: "522702", PostalCode: "550500"},
+					{Name: "荔波县", Code: "522722", PostalCode: "558400"},
+					{Name: "贵定县", Code: "522723", PostalCode: "551300"},
+					{Name: "瓮安县", Code: "522725", PostalCode: "550400"},
+					{Name: "独山县", Code: "522726", PostalCode: "558200"},
+					{Name: "平塘县", Code: "522727", PostalCode: "558300"},
+					{Name: "罗甸县", Code: "522728", PostalCode: "550100"},
+					{Name: "长顺县", Code: "522729", PostalCode: "550700"},
+					{Name: "龙里县", Code: "522730", PostalCode: "551200"},
+					{Name: "惠水县", Code: "522731", PostalCode: "550600"},
+					{Name: "三都水族自治县", Code: "522732", PostalCode: "558100"},
+				},
+			},
 		},
 	},
 	{
-		Name: "云南省", Short: "云南", Code: "53",
+		Name: "云南省", Short: "云南", Abbr: "滇", Code: "53", Population: 4721,
 		Cities: []City{
-			{Name: "昆明市", AreaCodes: []string{"530100", "530102"}},
-			{Name: "曲靖市", AreaCodes: []string{"530300"}},
-			{Name: "玉溪市", AreaCodes: []string{"530400"}},
-			{Name: "保山市", AreaCodes: []string{"530500"}},
-			{Name: "昭通市", AreaCodes: []string{"530600"}},
-			{Name: "大理市", AreaCodes: []string{"532901"}},
+			{
+				Name: "昆明市", Code: "530100", Population: 846, PhoneCode: "0871", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "五华区", Code: "530102", PostalCode: "650000"},
+					{Name: "盘龙区", Code: "530103", PostalCode: "650000"},
+					{Name: "官渡区", Code: "530111", PostalCode: "650000"},
+					{Name: "西山区", Code: "530112", PostalCode: "650000"},
+					{Name: "东川区", Code: "530113", PostalCode: "654100"},
+					{Name: "呈贡区", Code: "530114", PostalCode: "650500"},
+					{Name: "晋宁区", Code: "530115", PostalCode: "650600", Since: 2016},
+					{Name: "富民县", Code: "530124", PostalCode: "650400"},
+					{Name: "宜良县", Code: "530125", PostalCode: "652100"},
+					{Name: "石林彝族自治县", Code: "530126", PostalCode: "652200"},
+					{Name: "嵩明县", Code: "530127", PostalCode: "651700"},
+					{Name: "禄劝彝族苗族自治县", Code: "530128", PostalCode: "651500"},
+					{Name: "寻甸回族彝族自治县", Code: "530129", PostalCode: "655200"},
+					{Name: "安宁市", Code: "530181", PostalCode: "650300"},
+				},
+			},
+			{
+				Name: "曲靖市", Code: "530300", Population: 577, PhoneCode: "0874", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "麒麟区", Code: "530302", PostalCode: "655000"},
+					{Name: "沾益区", Code: "530303", PostalCode: "655331", Since: 2016},
+					{Name: "马龙区", Code: "530304", PostalCode: "655100", Since: 2018},
+					{Name: "陆良县", Code: "530322", PostalCode: "655600"},
+					{Name: "师宗县", Code: "530323", PostalCode: "655700"},
+					{Name: "罗平县", Code: "530324", PostalCode: "655800"},
+					{Name: "富源县", Code: "530325", PostalCode: "655500"},
+					{Name: "会泽县", Code: "530326", PostalCode: "654200"},
+					{Name: "宣威市", Code: "530381", PostalCode: "655400"},
+				},
+			},
+			{
+				Name: "玉溪市", Code: "530400", Population: 225, PhoneCode: "0877", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "红塔区", Code: "530402", PostalCode: "653100"},
+					{Name: "江川区", Code: "530403", PostalCode: "652600", Since: 2015},
+					{Name: "通海县", Code: "530423", PostalCode: "652700"},
+					{Name: "华宁县", Code: "530424", PostalCode: "652800"},
+					{Name: "易门县", Code: "530425", PostalCode: "651100"},
+					{Name: "峨山彝族自治县", Code: "530426", PostalCode: "653200"},
+					{Name: "新平彝族傣族自治县", Code: "530427", PostalCode: "653400"},
+					{Name: "元江哈尼族彝族傣族自治县", Code: "530428", PostalCode: "653300"},
+					{Name: "澄江市", Code: "530481", PostalCode: "652500", Since: 2019},
+				},
+			},
+			{
+				Name: "保山市", Code: "530500", Population: 243, PhoneCode: "0875", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "隆阳区", Code: "530502", PostalCode: "678000"},
+					{Name: "施甸县", Code: "530521", PostalCode: "678200"},
+					{Name: "龙陵县", Code: "530523", PostalCode: "678300"},
+					{Name: "昌宁县", Code: "530524", PostalCode: "678100"},
+					{Name: "腾冲市", Code: "530581", PostalCode: "679100"},
+				},
+			},
+			{
+				Name: "昭通市", Code: "530600", Population: 509, PhoneCode: "0870", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "昭阳区", Code: "530602", PostalCode: "657000"},
+					{Name: "鲁甸县", Code: "530621", PostalCode: "657100"},
+					{Name: "巧家县", Code: "530622", PostalCode: "654600"},
+					{Name: "盐津县", Code: "530623", PostalCode: "657500"},
+					{Name: "大关县", Code: "530624", PostalCode: "657400"},
+					{Name: "永善县", Code: "530625", PostalCode: "657300"},
+					{Name: "绥江县", Code: "530626", PostalCode: "657700"},
+					{Name: "镇雄县", Code: "530627", PostalCode: "657200"},
+					{Name: "彝良县", Code: "530628", PostalCode: "657600"},
+					{Name: "威信县", Code: "530629", PostalCode: "657900"},
+					{Name: "水富市", Code: "530681", PostalCode: "657800"},
+				},
+			},
+			{
+				Name: "丽江市", Code: "530700", Population: 125, PhoneCode: "0888", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "古城区", Code: "530702", PostalCode: "674100"},
+					{Name: "玉龙纳西族自治县", Code: "530721", PostalCode: "674100"},
+					{Name: "永胜县", Code: "530722", PostalCode: "674200"},
+					{Name: "华坪县", Code: "530723", PostalCode: "674800"},
+					{Name: "宁蒗彝族自治县", Code: "530724", PostalCode: "674300"},
+				},
+			},
+			{
+				Name: "普洱市", Code: "530800", Population: 240, PhoneCode: "0879", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "思茅区", Code: "530802", PostalCode: "665000"},
+					{Name: "宁洱哈尼族彝族自治县", Code: "530821", PostalCode: "665100"},
+					{Name: "墨江哈尼族自治县", Code: "530822", PostalCode: "654800"},
+					{Name: "景东彝族自治县", Code: "530823", PostalCode: "676200"},
+					{Name: "景谷傣族彝族自治县", Code: "530824", PostalCode: "666400"},
+					{Name: "镇沅彝族哈尼族拉祜族自治县", Code: "530825", PostalCode: "666500"},
+					{Name: "江城哈尼族彝族自治县", Code: "530826", PostalCode: "665900"},
+					{Name: "孟连傣族拉祜族佤族自治县", Code: "530827", PostalCode: "665800"},
+					{Name: "澜沧拉祜族自治县", Code: "530828", PostalCode: "665600"},
+					{Name: "西盟佤族自治县", Code: "530829", PostalCode: "665700"},
+				},
+			},
+			{
+				Name: "临沧市", Code: "530900", Population: 226, PhoneCode: "0883", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "临翔区", Code: "530902", PostalCode: "677000"},
+					{Name: "凤庆县", Code: "530921", PostalCode: "675900"},
+					{Name: "云县", Code: "530922", PostalCode: "675800"},
+					{Name: "永德县", Code: "530923", PostalCode: "677600"},
+					{Name: "镇康县", Code: "530924", PostalCode: "677700"},
+					{Name: "双江拉祜族佤族布朗族傣族自治县", Code: "530925", PostalCode: "677300"},
+					{Name: "耿马傣族佤族自治县", Code: "530926", PostalCode: "677500"},
+					{Name: "沧源佤族自治县", Code: "530927", PostalCode: "677400"},
+				},
+			},
+			{
+				Name: "楚雄彝族自治州", Code: "532300", Population: 241, PhoneCode: "0878", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "楚雄市", Code: "532301", PostalCode: "675000"},
+					{Name: "禄丰市", Code: "532302", PostalCode: "651200"},
+					{Name: "双柏县", Code: "532322", PostalCode: "675100"},
+					{Name: "牟定县", Code: "532323", PostalCode: "675500"},
+					{Name: "南华县", Code: "532324", PostalCode: "675200"},
+					{Name: "姚安县", Code: "532325", PostalCode: "675300"},
+					{Name: "大姚县", Code: "532326", PostalCode: "675400"},
+					{Name: "永仁县", Code: "532327", PostalCode: "651400"},
+					{Name: "元谋县", Code: "532328", PostalCode: "651300"},
+					{Name: "武定县", Code: "532329", PostalCode: "651600"},
+				},
+			},
+			{
+				Name: "红河哈尼族彝族自治州", Code: "532500", Population: 448, PhoneCode: "0873", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "个旧市", Code: "532501", PostalCode: "661000"},
+					{Name: "开远市", Code: "532502", PostalCode: "661600"},
+					{Name: "蒙自市", Code: "532503", PostalCode: "661100"},
+					{Name: "弥勒市", Code: "532504", PostalCode: "652300"},
+					{Name: "屏边苗族自治县", Code: "532523", PostalCode: "661200"},
+					{Name: "建水县", Code: "532524", PostalCode: "654300"},
+					{Name: "石屏县", Code: "532525", PostalCode: "662200"},
+					{Name: "泸西县", Code: "532527", PostalCode: "652400"},
+					{Name: "元阳县", Code: "532528", PostalCode: "662400"},
+					{Name: "红河县", Code: "532529", PostalCode: "654400"},
+					{Name: "金平苗族瑶族傣族自治县", Code: "532530", PostalCode: "661500"},
+					{Name: "绿春县", Code: "532531", PostalCode: "662500"},
+					{Name: "河口瑶族自治县", Code: "532532", PostalCode: "661300"},
+				},
+			},
+			{
+				Name: "文山壮族苗族自治州", Code: "532600", Population: 350, PhoneCode: "0876", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "文山市", Code: "532601", PostalCode: "663000"},
+					{Name: "砚山县", Code: "532622", PostalCode: "663100"},
+					{Name: "西畴县", Code: "532623", PostalCode: "663500"},
+					{Name: "麻栗坡县", Code: "532624", PostalCode: "663600"},
+					{Name: "马关县", Code: "532625", PostalCode: "663700"},
+					{Name: "丘北县", Code: "532626", PostalCode: "663200"},
+					{Name: "广南县", Code: "532627", PostalCode: "663300"},
+					{Name: "富宁县", Code: "532628", PostalCode: "663400"},
+				},
+			},
+			{
+				Name: "西双版纳傣族自治州", Code: "532800", Population: 130, PhoneCode: "0691", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "景洪市", Code: "532801", PostalCode: "666100"},
+					{Name: "勐海县", Code: "532822", PostalCode: "666200"},
+					{Name: "勐腊县", Code: "532823", PostalCode: "666300"},
+				},
+			},
+			{
+				Name: "大理白族自治州", Code: "532900", Population: 334, PhoneCode: "0872", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "大理市", Code: "532901", PostalCode: "671000"},
+					{Name: "漾濞彝族自治县", Code: "532922", PostalCode: "672500"},
+					{Name: "祥云县", Code: "532923", PostalCode: "672100"},
+					{Name: "宾川县", Code: "532924", PostalCode: "671600"},
+					{Name: "弥渡县", Code: "532925", PostalCode: "675600"},
+					{Name: "南涧彝族自治县", Code: "532926", PostalCode: "675700"},
+					{Name: "巍山彝族回族自治县", Code: "532927", PostalCode: "672400"},
+					{Name: "永平县", Code: "532928", PostalCode: "672600"},
+					{Name: "云龙县", Code: "532929", PostalCode: "672700"},
+					{Name: "洱源县", Code: "532930", PostalCode: "671200"},
+					{Name: "剑川县", Code: "532931", PostalCode: "671300"},
+					{Name: "鹤庆县", Code: "532932", PostalCode: "671500"},
+				},
+			},
+			{
+				Name: "德宏傣族景颇族自治州", Code: "533100", Population: 132, PhoneCode: "0692", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "瑞丽市", Code: "533102", PostalCode: "678600"},
+					{Name: "芒市", Code: "533103", PostalCode: "678400"},
+					{Name: "梁河县", Code: "533122", PostalCode: "679200"},
+					{Name: "盈江县", Code: "533123", PostalCode: "679300"},
+					{Name: "陇川县", Code: "533124", PostalCode: "678700"},
+				},
+			},
+			{
+				Name: "怒江傈僳族自治州", Code: "533300", Population: 55, PhoneCode: "0886", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "泸水市", Code: "533301", PostalCode: "673100"},
+					{Name: "福贡县", Code: "533323", PostalCode: "673400"},
+					{Name: "贡山独龙族怒族自治县", Code: "533324", PostalCode: "673500"},
+					{Name: "兰坪白族普米族自治县", Code: "533325", PostalCode: "671400"},
+				},
+			},
+			{
+				Name: "迪庆藏族自治州", Code: "533400", Population: 39, PhoneCode: "0887", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "香格里拉市", Code: "533401", PostalCode: "674400"},
+					{Name: "德钦县", Code: "533422", PostalCode: "674500"},
+					{Name: "维西傈僳族自治县", Code: "533423", PostalCode: "674600"},
+				},
+			},
 		},
 	},
 	{
-		Name: "西藏自治区", Short: "西藏", Code: "54",
+		Name: "西藏自治区", Short: "西藏", Abbr: "藏", Code: "54", Population: 365,
 		Cities: []City{
-			{Name: "拉萨市", AreaCodes: []string{"540100", "540102"}},
-			{Name: "日喀则市", AreaCodes: []string{"540200"}},
-			{Name: "昌都市", AreaCodes: []string{"540300"}},
-			{Name: "林芝市", AreaCodes: []string{"540400"}},
+			{
+				Name: "拉萨市", Code: "540100", Population: 87, PhoneCode: "0891", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城关区", Code: "540102", PostalCode: "850000"},
+					{Name: "堆龙德庆区", Code: "540103", PostalCode: "851400", Since: 2015},
+					{Name: "达孜区", Code: "540104", PostalCode: "850100", Since: 2017},
+					{Name: "林周县", Code: "540121", PostalCode: "851600"},
+					{Name: "当雄县", Code: "540122", PostalCode: "851500"},
+					{Name: "尼木县", Code: "540123", PostalCode: "851300"},
+					{Name: "曲水县", Code: "540124", PostalCode: "850600"},
+					{Name: "墨竹工卡县", Code: "540127", PostalCode: "850200"},
+				},
+			},
+			{
+				Name: "日喀则市", Code: "540200", Population: 80, PhoneCode: "0892", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "桑珠孜区", Code: "540202", PostalCode: "857000", Since: 2014},
+					{Name: "南木林县", Code: "540221", PostalCode: "857100"},
+					{Name: "江孜县", Code: "540222", PostalCode: "857400"},
+					{Name: "定日县", Code: "540223", PostalCode: "858200"},
+					{Name: "萨迦县", Code: "540224", PostalCode: "857800"},
+					{Name: "拉孜县", Code: "540225", PostalCode: "858100"},
+					{Name: "昂仁县", Code: "540226", PostalCode: "858500"},
+					{Name: "谢通门县", Code: "540227", PostalCode: "858900"},
+					{Name: "白朗县", Code: "540228", PostalCode: "857300"},
+					{Name: "仁布县", Code: "540229", PostalCode: "857200"},
+					{Name: "康马县", Code: "540230", PostalCode: "857500"},
+					{Name: "定结县", Code: "540231", PostalCode: "857900"},
+					{Name: "仲巴县", Code: "540232", PostalCode: "858800"},
+					{Name: "亚东县", Code: "540233", PostalCode: "857600"},
+					{Name: "吉隆县", Code: "540234", PostalCode: "858700"},
+					{Name: "聂拉木县", Code: "540235", PostalCode: "858300"},
+					{Name: "萨嘎县", Code: "540236", PostalCode: "858600"},
+					{Name: "岗巴县", Code: "540237", PostalCode: "857700"},
+				},
+			},
+			{
+				Name: "昌都市", Code: "540300", Population: 76, PhoneCode: "0895", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "卡若区", Code: "540302", PostalCode: "854000", Since: 2014},
+					{Name: "江达县", Code: "540321", PostalCode: "854100"},
+					{Name: "贡觉县", Code: "540322", PostalCode: "854200"},
+					{Name: "类乌齐县", Code: "540323", PostalCode: "855600"},
+					{Name: "丁青县", Code: "540324", PostalCode: "855700"},
+					{Name: "察雅县", Code: "540325", PostalCode: "854300"},
+					{Name: "八宿县", Code: "540326", PostalCode: "854600"},
+					{Name: "左贡县", Code: "540327", PostalCode: "854400"},
+					{Name: "芒康县", Code: "540328", PostalCode: "854500"},
+					{Name: "洛隆县", Code: "540329", PostalCode: "855400"},
+					{Name: "边坝县", Code: "540330", PostalCode: "855500"},
+				},
+			},
+			{
+				Name: "林芝市", Code: "540400", Population: 24, PhoneCode: "0894", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "巴宜区", Code: "540402", PostalCode: "860000", Since: 2015},
+					{Name: "工布江达县", Code: "540421", PostalCode: "860200"},
+					{Name: "米林县", Code: "540422", PostalCode: "860500"},
+					{Name: "墨脱县", Code: "540423", PostalCode: "860700"},
+					{Name: "波密县", Code: "540424", PostalCode: "860300"},
+					{Name: "察隅县", Code: "540425", PostalCode: "860600"},
+					{Name: "朗县", Code: "540426", PostalCode: "860400"},
+				},
+			},
+			{
+				Name: "山南市", Code: "540500", Population: 35, PhoneCode: "0893", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "乃东区", Code: "540502", PostalCode: "856100", Since: 2016},
+					{Name: "扎囊县", Code: "540521", PostalCode: "850800"},
+					{Name: "贡嘎县", Code: "540522", PostalCode: "850700"},
+					{Name: "桑日县", Code: "540523", PostalCode: "856200"},
+					{Name: "琼结县", Code: "540524", PostalCode: "856800"},
+					{Name: "曲松县", Code: "540525", PostalCode: "856300"},
+					{Name: "措美县", Code: "540526", PostalCode: "856900"},
+					{Name: "洛扎县", Code: "540527", PostalCode: "851200"},
+					{Name: "加查县", Code: "540528", PostalCode: "856400"},
+					{Name: "隆子县", Code: "540529", PostalCode: "856600"},
+					{Name: "错那县", Code: "540530", PostalCode: "856700"},
+					{Name: "浪卡子县", Code: "540531", PostalCode: "851100"},
+				},
+			},
+			{
+				Name: "那曲市", Code: "540600", Population: 50, PhoneCode: "0896", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "色尼区", Code: "540602", PostalCode: "852000", Since: 2017},
+					{Name: "嘉黎县", Code: "540621", PostalCode: "852400"},
+					{Name: "比如县", Code: "540622", PostalCode: "852300"},
+					{Name: "聂荣县", Code: "540623", PostalCode: "853500"},
+					{Name: "安多县", Code: "540624", PostalCode: "853400"},
+					{Name: "申扎县", Code: "540625", PostalCode: "853100"},
+					{Name: "索县", Code: "540626", PostalCode: "852200"},
+					{Name: "班戈县", Code: "540627", PostalCode: "852500"},
+					{Name: "巴青县", Code: "540628", PostalCode: "852100"},
+					{Name: "尼玛县", Code: "540629", PostalCode: "852600"},
+					{Name: "双湖县", Code: "540630", PostalCode: "853600"},
+				},
+			},
+			{
+				Name: "阿里地区", Code: "542500", Population: 12, PhoneCode: "0897", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "普兰县", Code: "542521", PostalCode: "859500"},
+					{Name: "札达县", Code: "542522", PostalCode: "859600"},
+					{Name: "噶尔县", Code: "542523", PostalCode: "859000"},
+					{Name: "日土县", Code: "542524", PostalCode: "859700"},
+					{Name: "革吉县", Code: "542525", PostalCode: "859100"},
+					{Name: "改则县", Code: "542526", PostalCode: "859200"},
+					{Name: "措勤县", Code: "542527", PostalCode: "859300"},
+				},
+			},
 		},
 	},
 	{
-		Name: "陕西省", Short: "陕西", Code: "61",
+		Name: "陕西省", Short: "陕西", Abbr: "陕", Code: "61", Population: 3953,
 		Cities: []City{
-			{Name: "西安市", AreaCodes: []string{"610100", "610102", "610103"}},
-			{Name: "铜川市", AreaCodes: []string{"610200"}},
-			{Name: "宝鸡市", AreaCodes: []string{"610300"}},
-			{Name: "咸阳市", AreaCodes: []string{"610400"}},
-			{Name: "渭南市", AreaCodes: []string{"610500"}},
-			{Name: "延安市", AreaCodes: []string{"610600"}},
+			{
+				Name: "西安市", Code: "610100", Population: 1295, PhoneCode: "029", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "新城区", Code: "610102", PostalCode: "710000"},
+					{Name: "碑林区", Code: "610103", PostalCode: "710000"},
+					{Name: "莲湖区", Code: "610104", PostalCode: "710000"},
+					{Name: "灞桥区", Code: "610111", PostalCode: "710000"},
+					{Name: "未央区", Code: "610112", PostalCode: "710000"},
+					{Name: "雁塔区", Code: "610113", PostalCode: "710000"},
+					{Name: "阎良区", Code: "610114", PostalCode: "710089"},
+					{Name: "临潼区", Code: "610115", PostalCode: "710600"},
+					{Name: "长安区", Code: "610116", PostalCode: "710100"},
+					{Name: "高陵区", Code: "610117", PostalCode: "710200", Since: 2014},
+					{Name: "鄠邑区", Code: "610118", PostalCode: "710300", Since: 2016},
+					{Name: "蓝田县", Code: "610122", PostalCode: "710500"},
+					{Name: "周至县", Code: "610124", PostalCode: "710400"},
+				},
+			},
+			{
+				Name: "铜川市", Code: "610200", Population: 70, PhoneCode: "0919", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "王益区", Code: "610202", PostalCode: "727000"},
+					{Name: "印台区", Code: "610203", PostalCode: "727000"},
+					{Name: "耀州区", Code: "610204", PostalCode: "727100"},
+					{Name: "宜君县", Code: "610222", PostalCode: "727200"},
+				},
+			},
+			{
+				Name: "宝鸡市", Code: "610300", Population: 332, PhoneCode: "0917", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "渭滨区", Code: "610302", PostalCode: "721000"},
+					{Name: "金台区", Code: "610303", PostalCode: "721000"},
+					{Name: "陈仓区", Code: "610304", PostalCode: "721300"},
+					{Name: "凤翔区", Code: "610305", PostalCode: "721400", Since: 2021},
+					{Name: "岐山县", Code: "610323", PostalCode: "722400"},
+					{Name: "扶风县", Code: "610324", PostalCode: "722200"},
+					{Name: "眉县", Code: "610326", PostalCode: "722300"},
+					{Name: "陇县", Code: "610327", PostalCode: "721200"},
+					{Name: "千阳县", Code: "610328", PostalCode: "721100"},
+					{Name: "麟游县", Code: "610329", PostalCode: "721500"},
+					{Name: "凤县", Code: "610330", PostalCode: "721700"},
+					{Name: "太白县", Code: "610331", PostalCode: "721600"},
+				},
+			},
+			{
+				Name: "咸阳市", Code: "610400", Population: 396, PhoneCode: "029", PhoneDigits: 8,
+				Districts: []District{
+					{Name: "秦都区", Code: "610402", PostalCode: "712000"},
+					{Name: "杨陵区", Code: "610403", PostalCode: "712100"},
+					{Name: "渭城区", Code: "610404", PostalCode: "712000"},
+					{Name: "三原县", Code: "610422", PostalCode: "713800"},
+					{Name: "泾阳县", Code: "610423", PostalCode: "713700"},
+					{Name: "乾县", Code: "610424", PostalCode: "713300"},
+					{Name: "礼泉县", Code: "610425", PostalCode: "713200"},
+					{Name: "永寿县", Code: "610426", PostalCode: "713400"},
+					{Name: "长武县", Code: "610428", PostalCode: "713600"},
+					{Name: "旬邑县", Code: "610429", PostalCode: "711300"},
+					{Name: "淳化县", Code: "610430", PostalCode: "711200"},
+					{Name: "武功县", Code: "610431", PostalCode: "712200"},
+					{Name: "兴平市", Code: "610481", PostalCode: "713100"},
+					{Name: "彬州市", Code: "610482", PostalCode: "713500"},
+				},
+			},
+			{
+				Name: "渭南市", Code: "610500", Population: 469, PhoneCode: "0913", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "临渭区", Code: "610502", PostalCode: "714000"},
+					{Name: "华州区", Code: "610503", PostalCode: "714100"},
+					{Name: "潼关县", Code: "610522", PostalCode: "714300"},
+					{Name: "大荔县", Code: "610523", PostalCode: "715100"},
+					{Name: "合阳县", Code: "610524", PostalCode: "715300"},
+					{Name: "澄城县", Code: "610525", PostalCode: "715200"},
+					{Name: "蒲城县", Code: "610526", PostalCode: "715500"},
+					{Name: "白水县", Code: "610527", PostalCode: "715600"},
+					{Name: "富平县", Code: "610528", PostalCode: "711700"},
+					{Name: "韩城市", Code: "610581", PostalCode: "715400"},
+					{Name: "华阴市", Code: "610582", PostalCode: "714200"},
+				},
+			},
+			{
+				Name: "延安市", Code: "610600", Population: 228, PhoneCode: "0911", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "宝塔区", Code: "610602", PostalCode: "716000"},
+					{Name: "安塞区", Code: "610603", PostalCode: "717400"},
+					{Name: "延长县", Code: "610621", PostalCode: "717100"},
+					{Name: "延川县", Code: "610622", PostalCode: "717200"},
+					{Name: "志丹县", Code: "610625", PostalCode: "717500"},
+					{Name: "吴起县", Code: "610626", PostalCode: "717600"},
+					{Name: "甘泉县", Code: "610627", PostalCode: "716100"},
+					{Name: "富县", Code: "610628", PostalCode: "727500"},
+					{Name: "洛川县", Code: "610629", PostalCode: "727400"},
+					{Name: "宜川县", Code: "610630", PostalCode: "716200"},
+					{Name: "黄龙县", Code: "610631", PostalCode: "715700"},
+					{Name: "黄陵县", Code: "610632", PostalCode: "727300"},
+					{Name: "子长市", Code: "610681", PostalCode: "717300"},
+				},
+			},
+			{
+				Name: "汉中市", Code: "610700", Population: 321, PhoneCode: "0916", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "汉台区", Code: "610702", PostalCode: "723000"},
+					{Name: "南郑区", Code: "610703", PostalCode: "723100"},
+					{Name: "城固县", Code: "610722", PostalCode: "723200"},
+					{Name: "洋县", Code: "610723", PostalCode: "723300"},
+					{Name: "西乡县", Code: "610724", PostalCode: "723500"},
+					{Name: "勉县", Code: "610725", PostalCode: "724200"},
+					{Name: "宁强县", Code: "610726", PostalCode: "724400"},
+					{Name: "略阳县", Code: "610727", PostalCode: "724300"},
+					{Name: "镇巴县", Code: "610728", PostalCode: "723600"},
+					{Name: "留坝县", Code: "610729", PostalCode: "724100"},
+					{Name: "佛坪县", Code: "610730", PostalCode: "723400"},
+				},
+			},
+			{
+				Name: "榆林市", Code: "610800", Population: 362, PhoneCode: "0912", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "榆阳区", Code: "610802", PostalCode: "719000"},
+					{Name: "横山区", Code: "610803", PostalCode: "719100"},
+					{Name: "府谷县", Code: "610822", PostalCode: "719400"},
+					{Name: "靖边县", Code: "610824", PostalCode: "718500"},
+					{Name: "定边县", Code: "610825", PostalCode: "718600"},
+					{Name: "绥德县", Code: "610826", PostalCode: "718000"},
+					{Name: "米脂县", Code: "610827", PostalCode: "718100"},
+					{Name: "佳县", Code: "610828", PostalCode: "719200"},
+					{Name: "吴堡县", Code: "610829", PostalCode: "718200"},
+					{Name: "清涧县", Code: "610830", PostalCode: "718300"},
+					{Name: "子洲县", Code: "610831", PostalCode: "718400"},
+					{Name: "神木市", Code: "610881", PostalCode: "719300"},
+				},
+			},
+			{
+				Name: "安康市", Code: "610900", Population: 249, PhoneCode: "0915", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "汉滨区", Code: "610902", PostalCode: "725000"},
+					{Name: "汉阴县", Code: "610921", PostalCode: "725100"},
+					{Name: "石泉县", Code: "610922", PostalCode: "725200"},
+					{Name: "宁陕县", Code: "610923", PostalCode: "711600"},
+					{Name: "紫阳县", Code: "610924", PostalCode: "725300"},
+					{Name: "岚皋县", Code: "610925", PostalCode: "725400"},
+					{Name: "平利县", Code: "610926", PostalCode: "725500"},
+					{Name: "镇坪县", Code: "610927", PostalCode: "725600"},
+					{Name: "白河县", Code: "610929", PostalCode: "725800"},
+					{Name: "旬阳市", Code: "610981", PostalCode: "725700"},
+				},
+			},
+			{
+				Name: "商洛市", Code: "611000", Population: 204, PhoneCode: "0914", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "商州区", Code: "611002", PostalCode: "726000"},
+					{Name: "洛南县", Code: "611021", PostalCode: "726100"},
+					{Name: "丹凤县", Code: "611022", PostalCode: "726200"},
+					{Name: "商南县", Code: "611023", PostalCode: "726300"},
+					{Name: "山阳县", Code: "611024", PostalCode: "726400"},
+					{Name: "镇安县", Code: "611025", PostalCode: "711500"},
+					{Name: "柞水县", Code: "611026", PostalCode: "711400"},
+				},
+			},
 		},
 	},
 	{
-		Name: "甘肃省", Short: "甘肃", Code: "62",
+		Name: "甘肃省", Short: "甘肃", Abbr: "甘", Code: "62", Population: 2502,
 		Cities: []City{
-			{Name: "兰州市", AreaCodes: []string{"620100", "620102"}},
-			{Name: "嘉峪关市", AreaCodes: []string{"620200"}},
-			{Name: "金昌市", AreaCodes: []string{"620300"}},
-			{Name: "白银市", AreaCodes: []string{"620400"}},
-			{Name: "天水市", AreaCodes: []string{"620500"}},
+			{
+				Name: "兰州市", Code: "620100", Population: 436, PhoneCode: "0931", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城关区", Code: "620102", PostalCode: "730000"},
+					{Name: "七里河区", Code: "620103", PostalCode: "730000"},
+					{Name: "西固区", Code: "620104", PostalCode: "730000"},
+					{Name: "安宁区", Code: "620105", PostalCode: "730000"},
+					{Name: "红古区", Code: "620111", PostalCode: "730084"},
+					{Name: "永登县", Code: "620121", PostalCode: "730300"},
+					{Name: "皋兰县", Code: "620122", PostalCode: "730200"},
+					{Name: "榆中县", Code: "620123", PostalCode: "730100"},
+				},
+			},
+			{
+				Name: "嘉峪关市", Code: "620200", Population: 31, PhoneCode: "0937", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "嘉峪关市", Code: "620200", PostalCode: "735100"},
+				},
+			},
+			{
+				Name: "金昌市", Code: "620300", Population: 44, PhoneCode: "0935", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "金川区", Code: "620302", PostalCode: "737100"},
+					{Name: "永昌县", Code: "620321", PostalCode: "737200"},
+				},
+			},
+			{
+				Name: "白银市", Code: "620400", Population: 151, PhoneCode: "0943", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "白银区", Code: "620402", PostalCode: "730900"},
+					{Name: "平川区", Code: "620403", PostalCode: "730913"},
+					{Name: "靖远县", Code: "620421", PostalCode: "730600"},
+					{Name: "会宁县", Code: "620422", PostalCode: "730700"},
+					{Name: "景泰县", Code: "620423", PostalCode: "730400"},
+				},
+			},
+			{
+				Name: "天水市", Code: "620500", Population: 298, PhoneCode: "0938", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "秦州区", Code: "620502", PostalCode: "741000"},
+					{Name: "麦积区", Code: "620503", PostalCode: "741020"},
+					{Name: "清水县", Code: "620521", PostalCode: "741400"},
+					{Name: "秦安县", Code: "620522", PostalCode: "741600"},
+					{Name: "甘谷县", Code: "620523", PostalCode: "741200"},
+					{Name: "武山县", Code: "620524", PostalCode: "741300"},
+					{Name: "张家川回族自治县", Code: "620525", PostalCode: "741500"},
+				},
+			},
+			{
+				Name: "武威市", Code: "620600", Population: 146, PhoneCode: "0935", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "凉州区", Code: "620602", PostalCode: "733000"},
+					{Name: "民勤县", Code: "620621", PostalCode: "733300"},
+					{Name: "古浪县", Code: "620622", PostalCode: "733100"},
+					{Name: "天祝藏族自治县", Code: "620623", PostalCode: "733200"},
+				},
+			},
+			{
+				Name: "张掖市", Code: "620700", Population: 113, PhoneCode: "0936", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "甘州区", Code: "620702", PostalCode: "734000"},
+					{Name: "肃南裕固族自治县", Code: "620721", PostalCode: "734400"},
+					{Name: "民乐县", Code: "620722", PostalCode: "734500"},
+					{Name: "临泽县", Code: "620723", PostalCode: "734200"},
+					{Name: "高台县", Code: "620724", PostalCode: "734300"},
+					{Name: "山丹县", Code: "620725", PostalCode: "734100"},
+				},
+			},
+			{
+				Name: "平凉市", Code: "620800", Population: 185, PhoneCode: "0933", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "崆峒区", Code: "620802", PostalCode: "744000"},
+					{Name: "泾川县", Code: "620821", PostalCode: "744300"},
+					{Name: "灵台县", Code: "620822", PostalCode: "744400"},
+					{Name: "崇信县", Code: "620823", PostalCode: "744200"},
+					{Name: "庄浪县", Code: "620825", PostalCode: "744600"},
+					{Name: "静宁县", Code: "620826", PostalCode: "743400"},
+					{Name: "华亭市", Code: "620881", PostalCode: "744100"},
+				},
+			},
+			{
+				Name: "酒泉市", Code: "620900", Population: 106, PhoneCode: "0937", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "肃州区", Code: "620902", PostalCode: "735000"},
+					{Name: "金塔县", Code: "620921", PostalCode: "735300"},
+					{Name: "瓜州县", Code: "620922", PostalCode: "736100"},
+					{Name: "肃北蒙古族自治县", Code: "620923", PostalCode: "736300"},
+					{Name: "阿克塞哈萨克族自治县", Code: "620924", PostalCode: "736400"},
+					{Name: "玉门市", Code: "620981", PostalCode: "735200"},
+					{Name: "敦煌市", Code: "620982", PostalCode: "736200"},
+				},
+			},
+			{
+				Name: "庆阳市", Code: "621000", Population: 218, PhoneCode: "0934", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "西峰区", Code: "621002", PostalCode: "745000"},
+					{Name: "庆城县", Code: "621021", PostalCode: "745100"},
+					{Name: "环县", Code: "621022", PostalCode: "745700"},
+					{Name: "华池县", Code: "621023", PostalCode: "745600"},
+					{Name: "合水县", Code: "621024", PostalCode: "745400"},
+					{Name: "正宁县", Code: "621025", PostalCode: "745300"},
+					{Name: "宁县", Code: "621026", PostalCode: "745200"},
+					{Name: "镇原县", Code: "621027", PostalCode: "744500"},
+				},
+			},
+			{
+				Name: "定西市", Code: "621100", Population: 252, PhoneCode: "0932", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "安定区", Code: "621102", PostalCode: "743000"},
+					{Name: "通渭县", Code: "621121", PostalCode: "743300"},
+					{Name: "陇西县", Code: "621122", PostalCode: "748100"},
+					{Name: "渭源县", Code: "621123", PostalCode: "748200"},
+					{Name: "临洮县", Code: "621124", PostalCode: "730500"},
+					{Name: "漳县", Code: "621125", PostalCode: "748300"},
+					{Name: "岷县", Code: "621126", PostalCode: "748400"},
+				},
+			},
+			{
+				Name: "陇南市", Code: "621200", Population: 240, PhoneCode: "0939", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "武都区", Code: "621202", PostalCode: "746000"},
+					{Name: "成县", Code: "621221", PostalCode: "742500"},
+					{Name: "文县", Code: "621222", PostalCode: "746400"},
+					{Name: "宕昌县", Code: "621223", PostalCode: "748500"},
+					{Name: "康县", Code: "621224", PostalCode: "746500"},
+					{Name: "西和县", Code: "621225", PostalCode: "742100"},
+					{Name: "礼县", Code: "621226", PostalCode: "742200"},
+					{Name: "徽县", Code: "621227", PostalCode: "742300"},
+					{Name: "两当县", Code: "621228", PostalCode: "742400"},
+				},
+			},
+			{
+				Name: "临夏回族自治州", Code: "622900", Population: 211, PhoneCode: "0930", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "临夏市", Code: "622901", PostalCode: "731100"},
+					{Name: "临夏县", Code: "622921", PostalCode: "731800"},
+					{Name: "康乐县", Code: "622922", PostalCode: "731500"},
+					{Name: "永靖县", Code: "622923", PostalCode: "731600"},
+					{Name: "广河县", Code: "622924", PostalCode: "731300"},
+					{Name: "和政县", Code: "622925", PostalCode: "731200"},
+					{Name: "东乡族自治县", Code: "622926", PostalCode: "731400"},
+					{Name: "积石山保安族东乡族撒拉族自治县", Code: "622927", PostalCode: "731700"},
+				},
+			},
+			{
+				Name: "甘南藏族自治州", Code: "623000", Population: 69, PhoneCode: "0941", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "合作市", Code: "623001", PostalCode: "747000"},
+					{Name: "临潭县", Code: "623021", PostalCode: "747500"},
+					{Name: "卓尼县", Code: "623022", PostalCode: "747600"},
+					{Name: "舟曲县", Code: "623023", PostalCode: "746300"},
+					{Name: "迭部县", Code: "623024", PostalCode: "747400"},
+					{Name: "玛曲县", Code: "623025", PostalCode: "747300"},
+					{Name: "碌曲县", Code: "623026", PostalCode: "747200"},
+					{Name: "夏河县", Code: "623027", PostalCode: "747100"},
+				},
+			},
 		},
 	},
 	{
-		Name: "青海省", Short: "青海", Code: "63",
+		Name: "青海省", Short: "青海", Abbr: "青", Code: "63", Population: 592,
 		Cities: []City{
-			{Name: "西宁市", AreaCodes: []string{"630100", "630102"}},
-			{Name: "海东市", AreaCodes: []string{"630200"}},
-			{Name: "海北州", AreaCodes: []string{"632200"}},
-			{Name: "黄南州", AreaCodes: []string{"632300"}},
+			{
+				Name: "西宁市", Code: "630100", Population: 247, PhoneCode: "0971", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "城东区", Code: "630102", PostalCode: "810000"},
+					{Name: "城中区", Code: "630103", PostalCode: "810000"},
+					{Name: "城西区", Code: "630104", PostalCode: "810000"},
+					{Name: "城北区", Code: "630105", PostalCode: "810000"},
+					{Name: "湟中区", Code: "630106", PostalCode: "811600", Since: 2019},
+					{Name: "大通回族土族自治县", Code: "630121", PostalCode: "810100"},
+					{Name: "湟源县", Code: "630123", PostalCode: "812100"},
+				},
+			},
+			{
+				Name: "海东市", Code: "630200", Population: 136, PhoneCode: "0972", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "乐都区", Code: "630202", PostalCode: "810700", Since: 2013},
+					{Name: "平安区", Code: "630203", PostalCode: "810600", Since: 2015},
+					{Name: "民和回族土族自治县", Code: "630222", PostalCode: "810800"},
+					{Name: "互助土族自治县", Code: "630223", PostalCode: "810500"},
+					{Name: "化隆回族自治县", Code: "630224", PostalCode: "810900"},
+					{Name: "循化撒拉族自治县", Code: "630225", PostalCode: "811100"},
+				},
+			},
+			{
+				Name: "海北藏族自治州", Code: "632200", Population: 27, PhoneCode: "0970", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "门源回族自治县", Code: "632221", PostalCode: "810300"},
+					{Name: "祁连县", Code: "632222", PostalCode: "810400"},
+					{Name: "海晏县", Code: "632223", PostalCode: "812200"},
+					{Name: "刚察县", Code: "632224", PostalCode: "812300"},
+				},
+			},
+			{
+				Name: "黄南藏族自治州", Code: "632300", Population: 28, PhoneCode: "0973", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "同仁市", Code: "632301", PostalCode: "811300", Since: 2020},
+					{Name: "尖扎县", Code: "632322", PostalCode: "811200"},
+					{Name: "泽库县", Code: "632323", PostalCode: "811400"},
+					{Name: "河南蒙古族自治县", Code: "632324", PostalCode: "811500"},
+				},
+			},
+			{
+				Name: "海南藏族自治州", Code: "632500", Population: 45, PhoneCode: "0974", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "共和县", Code: "632521", PostalCode: "813000"},
+					{Name: "同德县", Code: "632522", PostalCode: "813200"},
+					{Name: "贵德县", Code: "632523", PostalCode: "811700"},
+					{Name: "兴海县", Code: "632524", PostalCode: "813300"},
+					{Name: "贵南县", Code: "632525", PostalCode: "813100"},
+				},
+			},
+			{
+				Name: "果洛藏族自治州", Code: "632600", Population: 22, PhoneCode: "0975", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "玛沁县", Code: "632621", PostalCode: "814000"},
+					{Name: "班玛县", Code: "632622", PostalCode: "814300"},
+					{Name: "甘德县", Code: "632623", PostalCode: "814100"},
+					{Name: "达日县", Code: "632624", PostalCode: "814200"},
+					{Name: "久治县", Code: "632625", PostalCode: "624700"},
+					{Name: "玛多县", Code: "632626", PostalCode: "813500"},
+				},
+			},
+			{
+				Name: "玉树藏族自治州", Code: "632700", Population: 43, PhoneCode: "0976", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "玉树市", Code: "632701", PostalCode: "815000"},
+					{Name: "杂多县", Code: "632722", PostalCode: "815300"},
+					{Name: "称多县", Code: "632723", PostalCode: "815100"},
+					{Name: "治多县", Code: "632724", PostalCode: "815400"},
+					{Name: "囊谦县", Code: "632725", PostalCode: "815200"},
+					{Name: "曲麻莱县", Code: "632726", PostalCode: "815500"},
+				},
+			},
+			{
+				Name: "海西蒙古族藏族自治州", Code: "632800", Population: 47, PhoneCode: "0977", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "格尔木市", Code: "632801", PostalCode: "816000"},
+					{Name: "德令哈市", Code: "632802", PostalCode: "817000"},
+					{Name: "茫崖市", Code: "632803", PostalCode: "816400"},
+					{Name: "乌兰县", Code: "632821", PostalCode: "817100"},
+					{Name: "都兰县", Code: "632822", PostalCode: "816100"},
+					{Name: "天峻县", Code: "632823", PostalCode: "817200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "宁夏回族自治区", Short: "宁夏", Code: "64",
+		Name: "宁夏回族自治区", Short: "宁夏", Abbr: "宁", Code: "64", Population: 720,
 		Cities: []City{
-			{Name: "银川市", AreaCodes: []string{"640100", "640104"}},
-			{Name: "石嘴山市", AreaCodes: []string{"640200"}},
-			{Name: "吴忠市", AreaCodes: []string{"640300"}},
-			{Name: "固原市", AreaCodes: []string{"640400"}},
+			{
+				Name: "银川市", Code: "640100", Population: 286, PhoneCode: "0951", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "兴庆区", Code: "640104", PostalCode: "750000"},
+					{Name: "西夏区", Code: "640105", PostalCode: "750000"},
+					{Name: "金凤区", Code: "640106", PostalCode: "750000"},
+					{Name: "永宁县", Code: "640121", PostalCode: "750100"},
+					{Name: "贺兰县", Code: "640122", PostalCode: "750200"},
+					{Name: "灵武市", Code: "640181", PostalCode: "751400"},
+				},
+			},
+			{
+				Name: "石嘴山市", Code: "640200", Population: 75, PhoneCode: "0952", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "大武口区", Code: "640202", PostalCode: "753000"},
+					{Name: "惠农区", Code: "640205", PostalCode: "753200"},
+					{Name: "平罗县", Code: "640221", PostalCode: "753400"},
+				},
+			},
+			{
+				Name: "吴忠市", Code: "640300", Population: 139, PhoneCode: "0953", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "利通区", Code: "640302", PostalCode: "751100"},
+					{Name: "红寺堡区", Code: "640303", PostalCode: "751900"},
+					{Name: "盐池县", Code: "640323", PostalCode: "751500"},
+					{Name: "同心县", Code: "640324", PostalCode: "751300"},
+					{Name: "青铜峡市", Code: "640381", PostalCode: "751600"},
+				},
+			},
+			{
+				Name: "固原市", Code: "640400", Population: 114, PhoneCode: "0954", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "原州区", Code: "640402", PostalCode: "756000"},
+					{Name: "西吉县", Code: "640422", PostalCode: "756200"},
+					{Name: "隆德县", Code: "640423", PostalCode: "756300"},
+					{Name: "泾源县", Code: "640424", PostalCode: "756400"},
+					{Name: "彭阳县", Code: "640425", PostalCode: "756500"},
+				},
+			},
+			{
+				Name: "中卫市", Code: "640500", Population: 107, PhoneCode: "0955", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "沙坡头区", Code: "640502", PostalCode: "755000"},
+					{Name: "中宁县", Code: "640521", PostalCode: "755100"},
+					{Name: "海原县", Code: "640522", PostalCode: "755200"},
+				},
+			},
 		},
 	},
 	{
-		Name: "新疆维吾尔自治区", Short: "新疆", Code: "65",
+		Name: "新疆维吾尔自治区", Short: "新疆", Abbr: "新", Code: "65", Population: 2585,
 		Cities: []City{
-			{Name: "乌鲁木齐市", AreaCodes: []string{"650100", "650102"}},
-			{Name: "克拉玛依市", AreaCodes: []string{"650200"}},
-			{Name: "吐鲁番市", AreaCodes: []string{"650400"}},
-			{Name: "哈密市", AreaCodes: []string{"650500"}},
-			{Name: "喀什地区", AreaCodes: []string{"653100"}},
+			{
+				Name: "乌鲁木齐市", Code: "650100", Population: 405, PhoneCode: "0991", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "天山区", Code: "650102", PostalCode: "830000"},
+					{Name: "沙依巴克区", Code: "650103", PostalCode: "830000"},
+					{Name: "新市区", Code: "650104", PostalCode: "830000"},
+					{Name: "水磨沟区", Code: "650105", PostalCode: "830000"},
+					{Name: "头屯河区", Code: "650106", PostalCode: "830000"},
+					{Name: "达坂城区", Code: "650107", PostalCode: "830039"},
+					{Name: "米东区", Code: "650109", PostalCode: "831400"},
+					{Name: "乌鲁木齐县", Code: "650121", PostalCode: "830063"},
+				},
+			},
+			{
+				Name: "克拉玛依市", Code: "650200", Population: 49, PhoneCode: "0990", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "独山子区", Code: "650202", PostalCode: "833600"},
+					{Name: "克拉玛依区", Code: "650203", PostalCode: "834000"},
+					{Name: "白碱滩区", Code: "650204", PostalCode: "834008"},
+					{Name: "乌尔禾区", Code: "650205", PostalCode: "834012"},
+				},
+			},
+			{
+				Name: "吐鲁番市", Code: "650400", Population: 69, PhoneCode: "0995", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "高昌区", Code: "650402", PostalCode: "838000"},
+					{Name: "鄯善县", Code: "650421", PostalCode: "838200"},
+					{Name: "托克逊县", Code: "650422", PostalCode: "838100"},
+				},
+			},
+			{
+				Name: "哈密市", Code: "650500", Population: 67, PhoneCode: "0902", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "伊州区", Code: "650502", PostalCode: "839000"},
+					{Name: "巴里坤哈萨克自治县", Code: "650521", PostalCode: "839200"},
+					{Name: "伊吾县", Code: "650522", PostalCode: "839300"},
+				},
+			},
+			{
+				Name: "昌吉回族自治州", Code: "652300", Population: 161, PhoneCode: "0994", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "昌吉市", Code: "652301", PostalCode: "831100"},
+					{Name: "阜康市", Code: "652302", PostalCode: "831500"},
+					{Name: "呼图壁县", Code: "652323", PostalCode: "831200"},
+					{Name: "玛纳斯县", Code: "652324", PostalCode: "832200"},
+					{Name: "奇台县", Code: "652325", PostalCode: "831800"},
+					{Name: "吉木萨尔县", Code: "652327", PostalCode: "831700"},
+					{Name: "木垒哈萨克自治县", Code: "652328", PostalCode: "831900"},
+				},
+			},
+			{
+				Name: "博尔塔拉蒙古自治州", Code: "652700", Population: 49, PhoneCode: "0909", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "博乐市", Code: "652701", PostalCode: "833400"},
+					{Name: "阿拉山口市", Code: "652702", PostalCode: "833418"},
+					{Name: "精河县", Code: "652722", PostalCode: "833300"},
+					{Name: "温泉县", Code: "652723", PostalCode: "833500"},
+				},
+			},
+			{
+				Name: "巴音郭楞蒙古自治州", Code: "652800", Population: 161, PhoneCode: "0996", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "库尔勒市", Code: "652801", PostalCode: "841000"},
+					{Name: "轮台县", Code: "652822", PostalCode: "841600"},
+					{Name: "尉犁县", Code: "652823", PostalCode: "841500"},
+					{Name: "若羌县", Code: "652824", PostalCode: "841800"},
+					{Name: "且末县", Code: "652825", PostalCode: "841900"},
+					{Name: "焉耆回族自治县", Code: "652826", PostalCode: "841100"},
+					{Name: "和静县", Code: "652827", PostalCode: "841300"},
+					{Name: "和硕县", Code: "652828", PostalCode: "841200"},
+					{Name: "博湖县", Code: "652829", PostalCode: "841400"},
+				},
+			},
+			{
+				Name: "阿克苏地区", Code: "652900", Population: 271, PhoneCode: "0997", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "阿克苏市", Code: "652901", PostalCode: "843000"},
+					{Name: "库车市", Code: "652902", PostalCode: "842000", Since: 2019},
+					{Name: "温宿县", Code: "652922", PostalCode: "843100"},
+					{Name: "沙雅县", Code: "652924", PostalCode: "842200"},
+					{Name: "新和县", Code: "652925", PostalCode: "842100"},
+					{Name: "拜城县", Code: "652926", PostalCode: "842300"},
+					{Name: "乌什县", Code: "652927", PostalCode: "843400"},
+					{Name: "阿瓦提县", Code: "652928", PostalCode: "843200"},
+					{Name: "柯坪县", Code: "652929", PostalCode: "843600"},
+				},
+			},
+			{
+				Name: "克孜勒苏柯尔克孜自治州", Code: "653000", Population: 62, PhoneCode: "0908", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "阿图什市", Code: "653001", PostalCode: "845350"},
+					{Name: "阿克陶县", Code: "653022", PostalCode: "845550"},
+					{Name: "阿合奇县", Code: "653023", PostalCode: "843500"},
+					{Name: "乌恰县", Code: "653024", PostalCode: "845450"},
+				},
+			},
+			{
+				Name: "喀什地区", Code: "653100", Population: 450, PhoneCode: "0998", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "喀什市", Code: "653101", PostalCode: "844000"},
+					{Name: "疏附县", Code: "653121", PostalCode: "844100"},
+					{Name: "疏勒县", Code: "653122", PostalCode: "844200"},
+					{Name: "英吉沙县", Code: "653123", PostalCode: "844500"},
+					{Name: "泽普县", Code: "653124", PostalCode: "844800"},
+					{Name: "莎车县", Code: "653125", PostalCode: "844700"},
+					{Name: "叶城县", Code: "653126", PostalCode: "844900"},
+					{Name: "麦盖提县", Code: "653127", PostalCode: "844600"},
+					{Name: "岳普湖县", Code: "653128", PostalCode: "844400"},
+					{Name: "伽师县", Code: "653129", PostalCode: "844300"},
+					{Name: "巴楚县", Code: "653130", PostalCode: "843800"},
+					{Name: "塔什库尔干塔吉克自治县", Code: "653131", PostalCode: "845250"},
+				},
+			},
+			{
+				Name: "和田地区", Code: "653200", Population: 250, PhoneCode: "0903", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "和田市", Code: "653201", PostalCode: "848000"},
+					{Name: "和田县", Code: "653221", PostalCode: "848000"},
+					{Name: "墨玉县", Code: "653222", PostalCode: "848100"},
+					{Name: "皮山县", Code: "653223", PostalCode: "845150"},
+					{Name: "洛浦县", Code: "653224", PostalCode: "848200"},
+					{Name: "策勒县", Code: "653225", PostalCode: "848300"},
+					{Name: "于田县", Code: "653226", PostalCode: "848400"},
+					{Name: "民丰县", Code: "653227", PostalCode: "848500"},
+				},
+			},
+			{
+				Name: "伊犁哈萨克自治州", Code: "654000", Population: 285, PhoneCode: "0999", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "伊宁市", Code: "654002", PostalCode: "835000"},
+					{Name: "奎屯市", Code: "654003", PostalCode: "833200"},
+					{Name: "霍尔果斯市", Code: "654004", PostalCode: "835221"},
+					{Name: "伊宁县", Code: "654021", PostalCode: "835100"},
+					{Name: "察布查尔锡伯自治县", Code: "654022", PostalCode: "835300"},
+					{Name: "霍城县", Code: "654023", PostalCode: "835200"},
+					{Name: "巩留县", Code: "654024", PostalCode: "835400"},
+					{Name: "新源县", Code: "654025", PostalCode: "835800"},
+					{Name: "昭苏县", Code: "654026", PostalCode: "835600"},
+					{Name: "特克斯县", Code: "654027", PostalCode: "835500"},
+					{Name: "尼勒克县", Code: "654028", PostalCode: "835700"},
+				},
+			},
+			{
+				Name: "塔城地区", Code: "654200", Population: 114, PhoneCode: "0901", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "塔城市", Code: "654201", PostalCode: "834700"},
+					{Name: "乌苏市", Code: "654202", PostalCode: "833000"},
+					{Name: "沙湾市", Code: "654203", PostalCode: "832100", Since: 2021},
+					{Name: "额敏县", Code: "654221", PostalCode: "834600"},
+					{Name: "托里县", Code: "654224", PostalCode: "834500"},
+					{Name: "裕民县", Code: "654225", PostalCode: "834800"},
+					{Name: "和布克赛尔蒙古自治县", Code: "654226", PostalCode: "834400"},
+				},
+			},
+			{
+				Name: "阿勒泰地区", Code: "654300", Population: 67, PhoneCode: "0906", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "阿勒泰市", Code: "654301", PostalCode: "836500"},
+					{Name: "布尔津县", Code: "654321", PostalCode: "836600"},
+					{Name: "富蕴县", Code: "654322", PostalCode: "836100"},
+					{Name: "福海县", Code: "654323", PostalCode: "836400"},
+					{Name: "哈巴河县", Code: "654324", PostalCode: "836700"},
+					{Name: "青河县", Code: "654325", PostalCode: "836200"},
+					{Name: "吉木乃县", Code: "654326", PostalCode: "836800"},
+				},
+			},
+			{
+				Name: "石河子市", Code: "659001", Population: 63, PhoneCode: "0993", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "石河子市", Code: "659001", PostalCode: "832000"},
+				},
+			},
+			{
+				Name: "阿拉尔市", Code: "659002", Population: 39, PhoneCode: "0997", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "阿拉尔市", Code: "659002", PostalCode: "843300"},
+				},
+			},
+			{
+				Name: "图木舒克市", Code: "659003", Population: 27, PhoneCode: "0998", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "图木舒克市", Code: "659003", PostalCode: "843806"},
+				},
+			},
+			{
+				Name: "五家渠市", Code: "659004", Population: 31, PhoneCode: "0994", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "五家渠市", Code: "659004", PostalCode: "831300"},
+				},
+			},
+			{
+				Name: "北屯市", Code: "659005", Population: 9, PhoneCode: "0906", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "北屯市", Code: "659005", PostalCode: "836000"},
+				},
+			},
+			{
+				Name: "铁门关市", Code: "659006", Population: 5, PhoneCode: "0996", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "铁门关市", Code: "659006", PostalCode: "841007"},
+				},
+			},
+			{
+				Name: "双河市", Code: "659007", Population: 5, PhoneCode: "0909", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "双河市", Code: "659007", PostalCode: "833408"},
+				},
+			},
+			{
+				Name: "可克达拉市", Code: "659008", Population: 9, PhoneCode: "0999", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "可克达拉市", Code: "659008", PostalCode: "835213"},
+				},
+			},
+			{
+				Name: "昆玉市", Code: "659009", Population: 6, PhoneCode: "0903", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "昆玉市", Code: "659009", PostalCode: "848116"},
+				},
+			},
+			{
+				Name: "胡杨河市", Code: "659010", Population: 4, PhoneCode: "0992", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "胡杨河市", Code: "659010", PostalCode: "833700"},
+				},
+			},
+			{
+				Name: "新星市", Code: "659011", Population: 3, PhoneCode: "0902", PhoneDigits: 7,
+				Districts: []District{
+					{Name: "新星市", Code: "659011", PostalCode: "839000"},
+				},
+			},
 		},
 	},
 }
 
-// ProvinceMap 省份名称到 Province 的映射
+// ProvinceMap 省份全称、简称及单字简称到 Province 的映射
 var ProvinceMap map[string]*Province
 
-// AreaCodeMap 6位地区码到省市信息的映射
+// AreaCodeMap 6位地区码到省、市、区县信息的映射
+// 地级行政区自身的代码（如 "330100"）也可查到，此时 District 为空；
+// 已撤销的历史代码（见 HistoricalAreaCodes）同样收录，Historical 为 true，
+// Province、City 为其承继代码当前所属的省、市，District 为撤销前的名称
 var AreaCodeMap map[string]struct {
-	Province string
-	City     string
+	Province   string
+	City       string
+	District   string
+	Historical bool
 }
 
 func init() {
 	ProvinceMap = make(map[string]*Province)
 	AreaCodeMap = make(map[string]struct {
-		Province string
-		City     string
+		Province   string
+		City       string
+		District   string
+		Historical bool
 	})
 
 	for i := range Provinces {
 		p := &Provinces[i]
 		ProvinceMap[p.Name] = p
 		ProvinceMap[p.Short] = p
+		ProvinceMap[p.Abbr] = p
 
 		for _, city := range p.Cities {
-			for _, code := range city.AreaCodes {
-				AreaCodeMap[code] = struct {
-					Province string
-					City     string
-				}{Province: p.Short, City: city.Name}
+			AreaCodeMap[city.Code] = struct {
+				Province   string
+				City       string
+				District   string
+				Historical bool
+			}{Province: p.Short, City: city.Name}
+			for _, district := range city.Districts {
+				AreaCodeMap[district.Code] = struct {
+					Province   string
+					City       string
+					District   string
+					Historical bool
+				}{Province: p.Short, City: city.Name, District: district.Name}
 			}
 		}
 	}
+
+	HistoricalAreaCodeMap = make(map[string]*HistoricalAreaCode)
+	for i := range HistoricalAreaCodes {
+		h := &HistoricalAreaCodes[i]
+		HistoricalAreaCodeMap[h.Code] = h
+	}
+	for _, h := range HistoricalAreaCodes {
+		area := AreaCodeMap[h.Current()]
+		area.District = h.Name
+		area.Historical = true
+		AreaCodeMap[h.Code] = area
+	}
 }
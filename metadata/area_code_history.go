@@ -0,0 +1,234 @@
+package metadata
+
+// HistoricalAreaCode 已撤销的县级行政区划代码
+// 身份证号码沿用首次登记时的地区码，撤县设区、区划合并前出生的人仍持有这些代码
+type HistoricalAreaCode struct {
+	Code      string // 6位地区码："330125"
+	Name      string // 撤销前名称："余杭县"
+	Since     int    // 启用年份，0 表示 1980 年以前即已使用
+	Until     int    // 撤销年份，自该年起不再赋码
+	Successor string // 承继代码："330184"，可能本身也已撤销
+}
+
+// ValidIn 返回该代码在指定年份是否仍在使用
+func (h *HistoricalAreaCode) ValidIn(year int) bool {
+	return h.Since <= year && year < h.Until
+}
+
+// Current 沿承继关系返回现行的地区码
+func (h *HistoricalAreaCode) Current() string {
+	code := h.Successor
+	for {
+		next, ok := HistoricalAreaCodeMap[code]
+		if !ok {
+			return code
+		}
+		code = next.Successor
+	}
+}
+
+// HistoricalAreaCodes 1980 年以来撤销的县级行政区划代码（GB/T 2260 历年版本）
+var HistoricalAreaCodes = []HistoricalAreaCode{
+	{Code: "110103", Name: "崇文区", Until: 2010, Successor: "110101"},
+	{Code: "110104", Name: "宣武区", Until: 2010, Successor: "110102"},
+	{Code: "110110", Name: "燕山区", Until: 1986, Successor: "110111"},
+	{Code: "110221", Name: "昌平县", Until: 1999, Successor: "110114"},
+	{Code: "110222", Name: "顺义县", Until: 1998, Successor: "110113"},
+	{Code: "110223", Name: "通县", Until: 1997, Successor: "110112"},
+	{Code: "110224", Name: "大兴县", Until: 2001, Successor: "110115"},
+	{Code: "110225", Name: "房山县", Until: 1986, Successor: "110111"},
+	{Code: "110226", Name: "平谷县", Until: 2001, Successor: "110117"},
+	{Code: "110227", Name: "怀柔县", Until: 2001, Successor: "110116"},
+	{Code: "110228", Name: "密云县", Until: 2015, Successor: "110118"},
+	{Code: "110229", Name: "延庆县", Until: 2015, Successor: "110119"},
+	{Code: "120107", Name: "塘沽区", Until: 2009, Successor: "120116"},
+	{Code: "120108", Name: "汉沽区", Until: 2009, Successor: "120116"},
+	{Code: "120109", Name: "大港区", Until: 2009, Successor: "120116"},
+	{Code: "120221", Name: "宁河县", Until: 2015, Successor: "120117"},
+	{Code: "120222", Name: "武清县", Until: 2000, Successor: "120114"},
+	{Code: "120223", Name: "静海县", Until: 2015, Successor: "120118"},
+	{Code: "120224", Name: "宝坻县", Until: 2001, Successor: "120115"},
+	{Code: "120225", Name: "蓟县", Until: 2016, Successor: "120119"},
+	{Code: "130103", Name: "桥东区", Until: 2014, Successor: "130102"},
+	{Code: "130124", Name: "栾城县", Until: 2014, Successor: "130111"},
+	{Code: "130182", Name: "藁城市", Until: 2014, Successor: "130109"},
+	{Code: "130185", Name: "鹿泉市", Until: 2014, Successor: "130110"},
+	{Code: "130223", Name: "滦县", Until: 2018, Successor: "130284"},
+	{Code: "130526", Name: "任县", Until: 2020, Successor: "130505"},
+	{Code: "130527", Name: "南和县", Until: 2020, Successor: "130506"},
+	{Code: "130621", Name: "满城县", Until: 2015, Successor: "130607"},
+	{Code: "130622", Name: "清苑县", Until: 2015, Successor: "130608"},
+	{Code: "130625", Name: "徐水县", Until: 2015, Successor: "130609"},
+	{Code: "140202", Name: "城区", Until: 2018, Successor: "140213"},
+	{Code: "140211", Name: "南郊区", Until: 2018, Successor: "140214"},
+	{Code: "140227", Name: "大同县", Until: 2018, Successor: "140215"},
+	{Code: "140402", Name: "城区", Until: 2018, Successor: "140403"},
+	{Code: "140421", Name: "长治县", Until: 2018, Successor: "140404"},
+	{Code: "140424", Name: "屯留县", Until: 2018, Successor: "140405"},
+	{Code: "140481", Name: "潞城市", Until: 2018, Successor: "140406"},
+	{Code: "140624", Name: "怀仁县", Until: 2018, Successor: "140681"},
+	{Code: "140726", Name: "太谷县", Until: 2019, Successor: "140703"},
+	{Code: "210122", Name: "辽中县", Until: 2016, Successor: "210115"},
+	{Code: "210282", Name: "普兰店市", Until: 2015, Successor: "210214"},
+	{Code: "220181", Name: "九台市", Until: 2014, Successor: "220113"},
+	{Code: "220381", Name: "公主岭市", Until: 2020, Successor: "220184"},
+	{Code: "230181", Name: "阿城市", Until: 2006, Successor: "230112"},
+	{Code: "230182", Name: "双城市", Until: 2014, Successor: "230113"},
+	{Code: "310102", Name: "南市区", Until: 2000, Successor: "310101"},
+	{Code: "310103", Name: "卢湾区", Until: 2011, Successor: "310101"},
+	{Code: "310108", Name: "闸北区", Until: 2015, Successor: "310106"},
+	{Code: "310119", Name: "南汇区", Since: 2001, Until: 2009, Successor: "310115"},
+	{Code: "310221", Name: "上海县", Until: 1992, Successor: "310112"},
+	{Code: "310222", Name: "嘉定县", Until: 1992, Successor: "310114"},
+	{Code: "310223", Name: "宝山县", Until: 1988, Successor: "310113"},
+	{Code: "310224", Name: "川沙县", Until: 1993, Successor: "310115"},
+	{Code: "310225", Name: "南汇县", Until: 2001, Successor: "310119"},
+	{Code: "310226", Name: "奉贤县", Until: 2001, Successor: "310120"},
+	{Code: "310227", Name: "松江县", Until: 1998, Successor: "310117"},
+	{Code: "310228", Name: "金山县", Until: 1997, Successor: "310116"},
+	{Code: "310229", Name: "青浦县", Until: 1999, Successor: "310118"},
+	{Code: "310230", Name: "崇明县", Until: 2016, Successor: "310151"},
+	{Code: "320103", Name: "白下区", Until: 2013, Successor: "320104"},
+	{Code: "320107", Name: "下关区", Until: 2013, Successor: "320106"},
+	{Code: "320124", Name: "溧水县", Until: 2013, Successor: "320117"},
+	{Code: "320125", Name: "高淳县", Until: 2013, Successor: "320118"},
+	{Code: "320202", Name: "崇安区", Until: 2015, Successor: "320213"},
+	{Code: "320203", Name: "南长区", Until: 2015, Successor: "320213"},
+	{Code: "320204", Name: "北塘区", Until: 2015, Successor: "320213"},
+	{Code: "320405", Name: "戚墅堰区", Until: 2015, Successor: "320412"},
+	{Code: "320482", Name: "金坛市", Until: 2015, Successor: "320413"},
+	{Code: "320502", Name: "沧浪区", Until: 2012, Successor: "320508"},
+	{Code: "320503", Name: "平江区", Until: 2012, Successor: "320508"},
+	{Code: "320504", Name: "金阊区", Until: 2012, Successor: "320508"},
+	{Code: "320584", Name: "吴江市", Until: 2012, Successor: "320509"},
+	{Code: "320602", Name: "崇川区", Until: 2020, Successor: "320613"},
+	{Code: "320611", Name: "港闸区", Until: 2020, Successor: "320613"},
+	{Code: "320621", Name: "海安县", Until: 2018, Successor: "320685"},
+	{Code: "320684", Name: "海门市", Until: 2020, Successor: "320614"},
+	{Code: "320802", Name: "清河区", Until: 2016, Successor: "320812"},
+	{Code: "320811", Name: "清浦区", Until: 2016, Successor: "320812"},
+	{Code: "320829", Name: "洪泽县", Until: 2016, Successor: "320813"},
+	{Code: "321088", Name: "江都市", Until: 2011, Successor: "321012"},
+	{Code: "330103", Name: "下城区", Until: 2021, Successor: "330105"},
+	{Code: "330104", Name: "江干区", Until: 2021, Successor: "330102"},
+	{Code: "330121", Name: "萧山县", Until: 1988, Successor: "330181"},
+	{Code: "330123", Name: "富阳县", Until: 1994, Successor: "330183"},
+	{Code: "330124", Name: "临安县", Until: 1996, Successor: "330185"},
+	{Code: "330125", Name: "余杭县", Until: 1994, Successor: "330184"},
+	{Code: "330126", Name: "建德县", Until: 1992, Successor: "330182"},
+	{Code: "330181", Name: "萧山市", Since: 1988, Until: 2001, Successor: "330109"},
+	{Code: "330183", Name: "富阳市", Since: 1994, Until: 2014, Successor: "330111"},
+	{Code: "330184", Name: "余杭市", Since: 1994, Until: 2001, Successor: "330110"},
+	{Code: "330185", Name: "临安市", Since: 1996, Until: 2017, Successor: "330112"},
+	{Code: "330204", Name: "江东区", Until: 2016, Successor: "330212"},
+	{Code: "330227", Name: "鄞县", Until: 2002, Successor: "330212"},
+	{Code: "330283", Name: "奉化市", Until: 2016, Successor: "330213"},
+	{Code: "330322", Name: "洞头县", Until: 2015, Successor: "330305"},
+	{Code: "330621", Name: "绍兴县", Until: 2013, Successor: "330603"},
+	{Code: "330682", Name: "上虞市", Until: 2013, Successor: "330604"},
+	{Code: "340208", Name: "三山区", Until: 2020, Successor: "340209"},
+	{Code: "340221", Name: "芜湖县", Until: 2020, Successor: "340210"},
+	{Code: "340222", Name: "繁昌县", Until: 2020, Successor: "340212"},
+	{Code: "340225", Name: "无为县", Since: 2011, Until: 2019, Successor: "340281"},
+	{Code: "341402", Name: "居巢区", Until: 2011, Successor: "340181"},
+	{Code: "341422", Name: "无为县", Until: 2011, Successor: "340225"},
+	{Code: "341822", Name: "广德县", Until: 2019, Successor: "341882"},
+	{Code: "350182", Name: "长乐市", Until: 2017, Successor: "350112"},
+	{Code: "350402", Name: "梅列区", Until: 2021, Successor: "350404"},
+	{Code: "350427", Name: "沙县", Until: 2021, Successor: "350405"},
+	{Code: "350625", Name: "长泰县", Until: 2021, Successor: "350605"},
+	{Code: "350681", Name: "龙海市", Until: 2021, Successor: "350604"},
+	{Code: "360122", Name: "新建县", Until: 2015, Successor: "360112"},
+	{Code: "360421", Name: "九江县", Until: 2017, Successor: "360404"},
+	{Code: "360427", Name: "星子县", Until: 2016, Successor: "360483"},
+	{Code: "360622", Name: "余江县", Until: 2018, Successor: "360603"},
+	{Code: "360721", Name: "赣县", Until: 2016, Successor: "360704"},
+	{Code: "360727", Name: "龙南县", Until: 2020, Successor: "360783"},
+	{Code: "360782", Name: "南康市", Until: 2013, Successor: "360703"},
+	{Code: "361121", Name: "上饶县", Until: 2019, Successor: "361104"},
+	{Code: "361122", Name: "广丰县", Until: 2015, Successor: "361103"},
+	{Code: "370125", Name: "济阳县", Until: 2018, Successor: "370115"},
+	{Code: "370181", Name: "章丘市", Until: 2016, Successor: "370114"},
+	{Code: "370282", Name: "即墨市", Until: 2017, Successor: "370215"},
+	{Code: "370284", Name: "胶南市", Until: 2012, Successor: "370211"},
+	{Code: "370634", Name: "长岛县", Until: 2020, Successor: "370614"},
+	{Code: "370684", Name: "蓬莱市", Until: 2020, Successor: "370614"},
+	{Code: "371202", Name: "莱城区", Until: 2019, Successor: "370116"},
+	{Code: "371203", Name: "钢城区", Until: 2019, Successor: "370117"},
+	{Code: "410306", Name: "吉利区", Until: 2021, Successor: "410308"},
+	{Code: "410322", Name: "孟津县", Until: 2021, Successor: "410308"},
+	{Code: "410381", Name: "偃师市", Until: 2021, Successor: "410307"},
+	{Code: "420321", Name: "郧县", Until: 2014, Successor: "420304"},
+	{Code: "420621", Name: "襄阳县", Until: 2010, Successor: "420607"},
+	{Code: "420821", Name: "京山县", Until: 2018, Successor: "420882"},
+	{Code: "421023", Name: "监利县", Until: 2020, Successor: "421088"},
+	{Code: "430122", Name: "望城县", Until: 2011, Successor: "430112"},
+	{Code: "430124", Name: "宁乡县", Until: 2017, Successor: "430182"},
+	{Code: "430221", Name: "株洲县", Until: 2018, Successor: "430212"},
+	{Code: "440102", Name: "东山区", Until: 2005, Successor: "440104"},
+	{Code: "440107", Name: "芳村区", Until: 2005, Successor: "440103"},
+	{Code: "440116", Name: "萝岗区", Until: 2014, Successor: "440112"},
+	{Code: "440181", Name: "番禺市", Since: 1992, Until: 2000, Successor: "440113"},
+	{Code: "440182", Name: "花都市", Since: 1993, Until: 2000, Successor: "440114"},
+	{Code: "440183", Name: "增城市", Since: 1993, Until: 2014, Successor: "440118"},
+	{Code: "440184", Name: "从化市", Since: 1994, Until: 2014, Successor: "440117"},
+	{Code: "440602", Name: "城区", Until: 2002, Successor: "440604"},
+	{Code: "440603", Name: "石湾区", Until: 2002, Successor: "440604"},
+	{Code: "440681", Name: "顺德市", Since: 1992, Until: 2002, Successor: "440606"},
+	{Code: "440682", Name: "南海市", Since: 1992, Until: 2002, Successor: "440605"},
+	{Code: "440683", Name: "三水市", Since: 1993, Until: 2002, Successor: "440607"},
+	{Code: "440684", Name: "高明市", Since: 1994, Until: 2002, Successor: "440608"},
+	{Code: "450122", Name: "武鸣县", Until: 2015, Successor: "450110"},
+	{Code: "450127", Name: "横县", Until: 2021, Successor: "450181"},
+	{Code: "460003", Name: "儋州市", Until: 2015, Successor: "460400"},
+	{Code: "500221", Name: "长寿县", Since: 1997, Until: 2001, Successor: "500115"},
+	{Code: "500223", Name: "潼南县", Since: 1997, Until: 2015, Successor: "500152"},
+	{Code: "500224", Name: "铜梁县", Since: 1997, Until: 2014, Successor: "500151"},
+	{Code: "500226", Name: "荣昌县", Since: 1997, Until: 2015, Successor: "500153"},
+	{Code: "500227", Name: "璧山县", Since: 1997, Until: 2014, Successor: "500120"},
+	{Code: "500228", Name: "梁平县", Since: 1997, Until: 2016, Successor: "500155"},
+	{Code: "500232", Name: "武隆县", Since: 1997, Until: 2016, Successor: "500156"},
+	{Code: "500234", Name: "开县", Since: 1997, Until: 2016, Successor: "500154"},
+	{Code: "500381", Name: "江津市", Since: 1997, Until: 2006, Successor: "500116"},
+	{Code: "500382", Name: "合川市", Since: 1997, Until: 2006, Successor: "500117"},
+	{Code: "500383", Name: "永川市", Since: 1997, Until: 2006, Successor: "500118"},
+	{Code: "500384", Name: "南川市", Since: 1997, Until: 2006, Successor: "500119"},
+	{Code: "510122", Name: "双流县", Until: 2015, Successor: "510116"},
+	{Code: "510124", Name: "郫县", Until: 2016, Successor: "510117"},
+	{Code: "510132", Name: "新津县", Until: 2020, Successor: "510118"},
+	{Code: "510202", Name: "市中区", Until: 1997, Successor: "500103"},
+	{Code: "510203", Name: "大渡口区", Until: 1997, Successor: "500104"},
+	{Code: "510211", Name: "江北区", Until: 1997, Successor: "500105"},
+	{Code: "510212", Name: "沙坪坝区", Until: 1997, Successor: "500106"},
+	{Code: "510213", Name: "九龙坡区", Until: 1997, Successor: "500107"},
+	{Code: "510214", Name: "南岸区", Until: 1997, Successor: "500108"},
+	{Code: "510215", Name: "北碚区", Until: 1997, Successor: "500109"},
+	{Code: "512081", Name: "简阳市", Until: 2016, Successor: "510185"},
+	{Code: "520221", Name: "水城县", Until: 2020, Successor: "520204"},
+	{Code: "520222", Name: "盘县", Until: 2017, Successor: "520281"},
+	{Code: "520522", Name: "黔西县", Until: 2021, Successor: "520581"},
+	{Code: "530122", Name: "晋宁县", Until: 2016, Successor: "530115"},
+	{Code: "530321", Name: "马龙县", Until: 2018, Successor: "530304"},
+	{Code: "530328", Name: "沾益县", Until: 2016, Successor: "530303"},
+	{Code: "530421", Name: "江川县", Until: 2015, Successor: "530403"},
+	{Code: "530422", Name: "澄江县", Until: 2019, Successor: "530481"},
+	{Code: "540125", Name: "堆龙德庆县", Until: 2015, Successor: "540103"},
+	{Code: "540126", Name: "达孜县", Until: 2017, Successor: "540104"},
+	{Code: "542121", Name: "昌都县", Until: 2014, Successor: "540302"},
+	{Code: "542221", Name: "乃东县", Until: 2016, Successor: "540502"},
+	{Code: "542301", Name: "日喀则市", Until: 2014, Successor: "540202"},
+	{Code: "542421", Name: "那曲县", Until: 2017, Successor: "540602"},
+	{Code: "542621", Name: "林芝县", Until: 2015, Successor: "540402"},
+	{Code: "610125", Name: "户县", Until: 2016, Successor: "610118"},
+	{Code: "610126", Name: "高陵县", Until: 2014, Successor: "610117"},
+	{Code: "610322", Name: "凤翔县", Until: 2021, Successor: "610305"},
+	{Code: "630122", Name: "湟中县", Until: 2019, Successor: "630106"},
+	{Code: "632121", Name: "平安县", Until: 2015, Successor: "630203"},
+	{Code: "632123", Name: "乐都县", Until: 2013, Successor: "630202"},
+	{Code: "632321", Name: "同仁县", Until: 2020, Successor: "632301"},
+	{Code: "652923", Name: "库车县", Until: 2019, Successor: "652902"},
+	{Code: "654223", Name: "沙湾县", Until: 2021, Successor: "654203"},
+}
+
+// HistoricalAreaCodeMap 历史地区码到 HistoricalAreaCode 的映射
+var HistoricalAreaCodeMap map[string]*HistoricalAreaCode
@@ -1,12 +1,22 @@
 package metadata
 
+// 银行卡类型，对应 CardBin.CardType
+const (
+	DebitCard      = "借记卡"
+	CreditCard     = "贷记卡"
+	SemiCreditCard = "准贷记卡"
+	PrepaidCard    = "预付费卡"
+)
+
+// CardBin 发卡行卡 BIN 信息
 type CardBin struct {
-	Name     string
-	Length   int
-	CardType string
-	Prefixes []int
+	Name     string // 发卡行
+	Length   int    // 卡号长度
+	CardType string // 卡类型：借记卡、贷记卡、准贷记卡、预付费卡
+	Prefixes []int  // 卡 BIN
 }
 
+// CardBins 各发卡行的卡 BIN，包括借记卡、信用卡（贷记卡、准贷记卡）与预付费卡
 var CardBins = []CardBin{
 	{
 		"工商银行",
@@ -472,4 +482,209 @@ var CardBins = []CardBin{
 			621088, 621726,
 		},
 	},
+	{
+		"工商银行",
+		16,
+		"贷记卡",
+		[]int{
+			427010, 427018, 427019, 427020, 427029, 427030, 427039, 438125, 438126, 451804, 451810, 451811, 458071, 489734, 489735, 489736, 510529, 524047, 525498, 530970, 530990, 548259, 548943, 622236, 622237, 622238, 622239, 622240, 622245, 622889, 625330, 625331, 625332, 625858, 625859, 625916, 625917,
+		},
+	},
+	{
+		"工商银行",
+		16,
+		"准贷记卡",
+		[]int{
+			622210, 622215, 622230, 622235,
+		},
+	},
+	{
+		"工商银行",
+		19,
+		"预付费卡",
+		[]int{
+			620200, 620302, 620402, 620403, 620404, 620406, 620407, 620409, 620410, 620411, 620412, 620502, 620503, 620512, 620602, 620604,
+		},
+	},
+	{
+		"农业银行",
+		16,
+		"贷记卡",
+		[]int{
+			403361, 404117, 404118, 404119, 404120, 404121, 463758, 514027, 519412, 519413, 520082, 520083, 552599, 558730, 622836, 622837, 625996, 625997, 625998, 628268,
+		},
+	},
+	{
+		"中国银行",
+		16,
+		"贷记卡",
+		[]int{
+			356833, 356835, 409665, 409666, 409668, 409669, 409670, 409671, 409672, 512315, 512316, 512411, 512412, 514957, 518378, 518379, 518474, 518475, 518476, 524865, 525745, 525746, 547766, 553131, 558868, 622750, 622751, 622755, 622756, 622757, 622758, 622759, 622761, 622762, 622763, 622764, 622765, 622788, 625905, 625906, 625907, 625908, 625909, 625910, 628312, 628313,
+		},
+	},
+	{
+		"中国银行",
+		16,
+		"准贷记卡",
+		[]int{
+			409667, 438088,
+		},
+	},
+	{
+		"中国银行",
+		19,
+		"预付费卡",
+		[]int{
+			620025, 620026, 620210, 620211, 620212, 620213, 620214, 620215, 620216, 620217,
+		},
+	},
+	{
+		"建设银行",
+		16,
+		"贷记卡",
+		[]int{
+			356895, 356896, 356899, 436718, 436728, 436738, 436745, 436748, 489592, 531693, 532450, 532458, 544887, 552801, 557080, 558895, 559051, 622166, 622168, 622708, 625964, 625965, 625966, 628266, 628366,
+		},
+	},
+	{
+		"建设银行",
+		16,
+		"准贷记卡",
+		[]int{
+			553242, 622725, 622728,
+		},
+	},
+	{
+		"交通银行",
+		16,
+		"贷记卡",
+		[]int{
+			434910, 458123, 458124, 520169, 521899, 522964, 552853, 622250, 622251, 622252, 622253, 622656, 628216, 628218,
+		},
+	},
+	{
+		"交通银行",
+		19,
+		"预付费卡",
+		[]int{
+			620013, 620021, 620521,
+		},
+	},
+	{
+		"招商银行",
+		16,
+		"贷记卡",
+		[]int{
+			356885, 356886, 356887, 356888, 356889, 356890, 439188, 439225, 439226, 439227, 479228, 479229, 521302, 545619, 545620, 545621, 545623, 545947, 545948, 552534, 552587, 622575, 622576, 622577, 622578, 622579, 622581, 622582, 625802, 625803, 628262, 628362,
+		},
+	},
+	{
+		"招商银行",
+		15,
+		"贷记卡",
+		[]int{
+			370285, 370286, 370287, 370289,
+		},
+	},
+	{
+		"浦东发展银行",
+		16,
+		"贷记卡",
+		[]int{
+			356851, 356852, 404738, 404739, 456418, 498451, 515672, 517650, 525998, 622177, 622276, 622277, 625957, 625958, 625970, 625971, 628221, 628222,
+		},
+	},
+	{
+		"中信银行",
+		16,
+		"贷记卡",
+		[]int{
+			400360, 403391, 403392, 403393, 404157, 404158, 404159, 404171, 404172, 404173, 404174, 514906, 520108, 556617, 558916, 622918, 622919, 628206, 628208, 628209,
+		},
+	},
+	{
+		"光大银行",
+		16,
+		"贷记卡",
+		[]int{
+			356837, 356838, 356839, 356840, 406252, 406254, 425862, 481699, 524090, 543159, 622161, 622570, 622650, 622655, 622657, 625975, 625976, 628201, 628202,
+		},
+	},
+	{
+		"民生银行",
+		16,
+		"贷记卡",
+		[]int{
+			356856, 356857, 356858, 356859, 407405, 421869, 421870, 421871, 512466, 517636, 528948, 552288, 556610, 622600, 622601, 622602, 622603, 625911, 628258,
+		},
+	},
+	{
+		"兴业银行",
+		16,
+		"贷记卡",
+		[]int{
+			451289, 451290, 486493, 486494, 486861, 523036, 528057, 622901, 622922, 625082, 625083, 628212,
+		},
+	},
+	{
+		"平安银行",
+		16,
+		"贷记卡",
+		[]int{
+			356868, 356869, 531659, 622155, 622156, 622157, 625360, 625361, 628296,
+		},
+	},
+	{
+		"广发银行股份有限公司",
+		16,
+		"贷记卡",
+		[]int{
+			406365, 406366, 428911, 436768, 436769, 487013, 491032, 491034, 491035, 491036, 491037, 491038, 518364, 520152, 520382, 528931, 548844, 552794, 558894, 622555, 622556, 622557, 622558, 622559, 622560, 625071, 625072, 628259,
+		},
+	},
+	{
+		"华夏银行",
+		16,
+		"贷记卡",
+		[]int{
+			523959, 528708, 528709, 539867, 539868, 622636, 622637, 622638, 628318,
+		},
+	},
+	{
+		"邮储银行",
+		16,
+		"贷记卡",
+		[]int{
+			622810, 622811, 622812, 625367, 625368, 625919, 628310,
+		},
+	},
+}
+
+// ClosedCardBins 已停业或被合并银行的卡 BIN，这些 BIN 已不再发卡，
+// 不在 CardBins 中出现
+var ClosedCardBins = []CardBin{
+	{
+		"深圳发展银行",
+		16,
+		"借记卡",
+		[]int{
+			622525, 622526,
+		},
+	},
+	{
+		"深圳发展银行",
+		16,
+		"贷记卡",
+		[]int{
+			435744, 435745,
+		},
+	},
+	{
+		"包商银行",
+		19,
+		"借记卡",
+		[]int{
+			621760, 622336,
+		},
+	},
 }
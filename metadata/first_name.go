@@ -1310,3 +1310,27 @@ var FemaleFirstNames = []string{
 	"贤花", "贤芳", "贤英", "贤珍", "贤玉", "贤香", "贤兰", "贤梅",
 	"惠花", "惠芳", "惠英", "惠珍", "惠玉", "惠香", "惠兰", "惠梅",
 }
+
+// MaleFirstNameFrequency 常见男性名字占男性人口的百分比，按公安部户籍人口重名统计整理
+// MaleFirstNames 中未列出的名字平分剩余的比例
+var MaleFirstNameFrequency = map[string]float64{
+	"伟": 0.62, "强": 0.36, "磊": 0.32, "军": 0.31, "洋": 0.30, "勇": 0.29, "杰": 0.28,
+	"涛": 0.26, "明": 0.25, "超": 0.25, "平": 0.23, "刚": 0.22, "敏": 0.20, "辉": 0.19,
+	"鹏": 0.18, "华": 0.18, "飞": 0.17, "斌": 0.16, "波": 0.16, "建华": 0.15, "建国": 0.15,
+	"建军": 0.14, "志强": 0.13, "浩": 0.13, "亮": 0.12, "林": 0.12, "峰": 0.12, "鑫": 0.11,
+	"宇": 0.11, "凯": 0.10, "健": 0.10, "龙": 0.10, "国强": 0.09, "海涛": 0.09, "建平": 0.09,
+	"志明": 0.08, "志刚": 0.08, "俊杰": 0.08, "浩然": 0.07, "浩宇": 0.07, "宇轩": 0.06,
+	"子轩": 0.06,
+}
+
+// FemaleFirstNameFrequency 常见女性名字占女性人口的百分比，按公安部户籍人口重名统计整理
+// FemaleFirstNames 中未列出的名字平分剩余的比例
+var FemaleFirstNameFrequency = map[string]float64{
+	"芳": 0.58, "娜": 0.52, "秀英": 0.50, "敏": 0.45, "静": 0.44, "丽": 0.40, "艳": 0.32,
+	"娟": 0.31, "秀兰": 0.27, "霞": 0.27, "桂英": 0.24, "玉兰": 0.21, "燕": 0.21, "秀珍": 0.19,
+	"玲": 0.19, "桂兰": 0.17, "丹": 0.17, "萍": 0.16, "红": 0.16, "梅": 0.15, "婷": 0.15,
+	"雪": 0.14, "琳": 0.13, "颖": 0.13, "倩": 0.12, "慧": 0.12, "洁": 0.11, "晶": 0.11,
+	"莉": 0.10, "海燕": 0.10, "丽娟": 0.09, "红梅": 0.09, "淑珍": 0.09, "凤英": 0.08,
+	"秀云": 0.08, "玉梅": 0.08, "玉珍": 0.08, "晓燕": 0.08, "欣怡": 0.07, "子涵": 0.07,
+	"梓涵": 0.06, "雨涵": 0.06, "诗涵": 0.05,
+}
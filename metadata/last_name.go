@@ -9,7 +9,7 @@ var SingleLastName = []string{
 	"戴", "崔", "任", "陆", "廖", "姚", "方", "金", "邱", "夏", "谭", "韦", "贾", "邹",
 	"石", "熊", "孟", "秦", "阎", "薛", "侯", "雷", "白", "龙", "段", "郝", "孔", "邵",
 	"史", "毛", "常", "万", "顾", "赖", "武", "康", "贺", "严", "尹", "钱", "施", "牛",
-	"洪", "龚",
+	"洪", "龚", "肖", "于", "付", "闫", "陶", "黎", "覃", "莫", "向", "汤",
 }
 
 // CompoundLastName 复姓 (罕见)
@@ -20,3 +20,31 @@ var CompoundLastName = []string{
 	"谷梁", "拓跋", "轩辕", "令狐", "百里", "呼延", "南门", "公户", "公玉", "公仪", "公仲",
 	"公上", "左丘", "公伯", "西门", "公乘", "公皙", "南荣",
 }
+
+// LastNameFrequency 姓氏占全国人口的百分比，按公安部户籍人口姓氏统计整理
+// 未列出的姓氏（多为复姓）按 RareLastNameFrequency 计
+var LastNameFrequency = map[string]float64{
+	"王": 7.12, "李": 6.95, "张": 6.70, "刘": 5.30, "陈": 4.70, "杨": 3.22, "黄": 2.45,
+	"赵": 2.30, "吴": 2.05, "周": 2.02, "徐": 1.38, "孙": 1.35, "马": 1.27, "朱": 1.22,
+	"胡": 1.20, "郭": 1.16, "何": 1.14, "林": 1.12, "高": 1.11, "罗": 0.99, "郑": 0.97,
+	"梁": 0.91, "谢": 0.82, "宋": 0.78, "唐": 0.77, "许": 0.74, "韩": 0.73, "冯": 0.70,
+	"邓": 0.69, "曹": 0.66, "彭": 0.63, "曾": 0.61, "肖": 0.59, "田": 0.57, "董": 0.56,
+	"袁": 0.55, "潘": 0.54, "于": 0.53, "蒋": 0.51, "蔡": 0.50, "余": 0.49, "杜": 0.48,
+	"叶": 0.48, "程": 0.47, "苏": 0.46, "魏": 0.45, "吕": 0.44, "丁": 0.43, "任": 0.42,
+	"沈": 0.41, "姚": 0.40, "卢": 0.40, "姜": 0.39, "崔": 0.38, "钟": 0.37, "谭": 0.36,
+	"陆": 0.35, "汪": 0.34, "范": 0.33, "金": 0.33, "石": 0.32, "廖": 0.31, "贾": 0.31,
+	"夏": 0.30, "韦": 0.30, "付": 0.29, "方": 0.29, "白": 0.28, "邹": 0.28, "孟": 0.27,
+	"熊": 0.27, "秦": 0.26, "邱": 0.26, "江": 0.25, "尹": 0.25, "薛": 0.24, "闫": 0.24,
+	"段": 0.23, "雷": 0.23, "侯": 0.22, "龙": 0.22, "史": 0.21, "陶": 0.21, "黎": 0.20,
+	"贺": 0.20, "顾": 0.19, "毛": 0.19, "郝": 0.19, "龚": 0.18, "邵": 0.18, "万": 0.17,
+	"钱": 0.17, "覃": 0.17, "严": 0.17, "武": 0.17, "戴": 0.17, "莫": 0.16, "孔": 0.16,
+	"向": 0.16, "汤": 0.15, "常": 0.15, "傅": 0.15, "赖": 0.14, "康": 0.14, "施": 0.13,
+	"牛": 0.12, "洪": 0.12, "阎": 0.05, "萧": 0.03, "於": 0.01,
+	// 复姓
+	"欧阳": 0.06, "上官": 0.003, "司徒": 0.003, "司马": 0.002, "诸葛": 0.002, "令狐": 0.002,
+	"东方": 0.001, "皇甫": 0.001, "尉迟": 0.0005, "夏侯": 0.0005, "慕容": 0.0003,
+	"宇文": 0.0003, "公孙": 0.0003, "长孙": 0.0002, "西门": 0.0002, "独孤": 0.0002,
+}
+
+// RareLastNameFrequency 未列入 LastNameFrequency 的罕见姓氏的人口百分比
+const RareLastNameFrequency = 0.0001
@@ -1,39 +1,89 @@
 package metadata
 
-var MobilePrefix = []string{
-	"130",
-	"131",
-	"132",
-	"133",
-	"134",
-	"135",
-	"136",
-	"137",
-	"138",
-	"139",
-	"145",
-	"147",
-	"150",
-	"151",
-	"152",
-	"153",
-	"155",
-	"156",
-	"157",
-	"158",
-	"159",
-	"170",
-	"176",
-	"177",
-	"178",
-	"180",
-	"181",
-	"182",
-	"183",
-	"184",
-	"185",
-	"186",
-	"187",
-	"188",
-	"189",
+// 基础运营商，对应 MobileSegment.Carrier
+const (
+	ChinaMobile   = "中国移动"
+	ChinaUnicom   = "中国联通"
+	ChinaTelecom  = "中国电信"
+	ChinaBroadnet = "中国广电"
+)
+
+// MobileSegment 手机号段
+type MobileSegment struct {
+	Prefix   string // 号段前缀，3 或 4 位："139"、"1703"
+	Carrier  string // 基础运营商；虚拟运营商号段为其承载网络的运营商
+	MVNO     bool   // 虚拟运营商号段
+	DataOnly bool   // 数据号段（上网卡、物联网卡），不能接打电话、收发短信
+}
+
+// MobileSegments 工信部分配的手机号段（截至 2024 年）。
+// 同一前缀下分属不同运营商的号段按 4 位前缀逐个列出，各前缀互不重叠
+var MobileSegments = []MobileSegment{
+	// === 中国移动 ===
+	{Prefix: "1340", Carrier: ChinaMobile}, {Prefix: "1341", Carrier: ChinaMobile},
+	{Prefix: "1342", Carrier: ChinaMobile}, {Prefix: "1343", Carrier: ChinaMobile},
+	{Prefix: "1344", Carrier: ChinaMobile}, {Prefix: "1345", Carrier: ChinaMobile},
+	{Prefix: "1346", Carrier: ChinaMobile}, {Prefix: "1347", Carrier: ChinaMobile},
+	{Prefix: "1348", Carrier: ChinaMobile},
+	{Prefix: "135", Carrier: ChinaMobile}, {Prefix: "136", Carrier: ChinaMobile},
+	{Prefix: "137", Carrier: ChinaMobile}, {Prefix: "138", Carrier: ChinaMobile},
+	{Prefix: "139", Carrier: ChinaMobile}, {Prefix: "150", Carrier: ChinaMobile},
+	{Prefix: "151", Carrier: ChinaMobile}, {Prefix: "152", Carrier: ChinaMobile},
+	{Prefix: "157", Carrier: ChinaMobile}, {Prefix: "158", Carrier: ChinaMobile},
+	{Prefix: "159", Carrier: ChinaMobile}, {Prefix: "178", Carrier: ChinaMobile},
+	{Prefix: "182", Carrier: ChinaMobile}, {Prefix: "183", Carrier: ChinaMobile},
+	{Prefix: "184", Carrier: ChinaMobile}, {Prefix: "187", Carrier: ChinaMobile},
+	{Prefix: "188", Carrier: ChinaMobile}, {Prefix: "195", Carrier: ChinaMobile},
+	{Prefix: "197", Carrier: ChinaMobile}, {Prefix: "198", Carrier: ChinaMobile},
+	{Prefix: "1440", Carrier: ChinaMobile, DataOnly: true},
+	{Prefix: "147", Carrier: ChinaMobile, DataOnly: true},
+	{Prefix: "148", Carrier: ChinaMobile, DataOnly: true},
+	{Prefix: "172", Carrier: ChinaMobile, DataOnly: true},
+
+	// === 中国联通 ===
+	{Prefix: "130", Carrier: ChinaUnicom}, {Prefix: "131", Carrier: ChinaUnicom},
+	{Prefix: "132", Carrier: ChinaUnicom}, {Prefix: "155", Carrier: ChinaUnicom},
+	{Prefix: "156", Carrier: ChinaUnicom}, {Prefix: "166", Carrier: ChinaUnicom},
+	{Prefix: "175", Carrier: ChinaUnicom}, {Prefix: "176", Carrier: ChinaUnicom},
+	{Prefix: "185", Carrier: ChinaUnicom}, {Prefix: "186", Carrier: ChinaUnicom},
+	{Prefix: "196", Carrier: ChinaUnicom},
+	{Prefix: "145", Carrier: ChinaUnicom, DataOnly: true},
+	{Prefix: "146", Carrier: ChinaUnicom, DataOnly: true},
+
+	// === 中国电信 ===
+	{Prefix: "133", Carrier: ChinaTelecom}, {Prefix: "1349", Carrier: ChinaTelecom},
+	{Prefix: "153", Carrier: ChinaTelecom}, {Prefix: "173", Carrier: ChinaTelecom},
+	{Prefix: "177", Carrier: ChinaTelecom}, {Prefix: "180", Carrier: ChinaTelecom},
+	{Prefix: "181", Carrier: ChinaTelecom}, {Prefix: "189", Carrier: ChinaTelecom},
+	{Prefix: "190", Carrier: ChinaTelecom}, {Prefix: "191", Carrier: ChinaTelecom},
+	{Prefix: "193", Carrier: ChinaTelecom}, {Prefix: "199", Carrier: ChinaTelecom},
+	{Prefix: "1410", Carrier: ChinaTelecom, DataOnly: true},
+	{Prefix: "149", Carrier: ChinaTelecom, DataOnly: true},
+
+	// === 中国广电 ===
+	{Prefix: "192", Carrier: ChinaBroadnet},
+
+	// === 虚拟运营商 ===
+	{Prefix: "1700", Carrier: ChinaTelecom, MVNO: true}, {Prefix: "1701", Carrier: ChinaTelecom, MVNO: true},
+	{Prefix: "1702", Carrier: ChinaTelecom, MVNO: true}, {Prefix: "162", Carrier: ChinaTelecom, MVNO: true},
+	{Prefix: "1703", Carrier: ChinaMobile, MVNO: true}, {Prefix: "1705", Carrier: ChinaMobile, MVNO: true},
+	{Prefix: "1706", Carrier: ChinaMobile, MVNO: true}, {Prefix: "165", Carrier: ChinaMobile, MVNO: true},
+	{Prefix: "1704", Carrier: ChinaUnicom, MVNO: true}, {Prefix: "1707", Carrier: ChinaUnicom, MVNO: true},
+	{Prefix: "1708", Carrier: ChinaUnicom, MVNO: true}, {Prefix: "1709", Carrier: ChinaUnicom, MVNO: true},
+	{Prefix: "167", Carrier: ChinaUnicom, MVNO: true}, {Prefix: "171", Carrier: ChinaUnicom, MVNO: true},
 }
+
+// MobilePrefix 基础运营商可接打电话的 3 位号段
+var MobilePrefix = func() []string {
+	var prefixes []string
+	seen := make(map[string]bool)
+	for _, s := range MobileSegments {
+		p := s.Prefix[:3]
+		if s.MVNO || s.DataOnly || seen[p] {
+			continue
+		}
+		seen[p] = true
+		prefixes = append(prefixes, p)
+	}
+	return prefixes
+}()
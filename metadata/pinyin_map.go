@@ -1,6 +1,6 @@
 package metadata
 
-// PinyinMap 汉字到拼音的映射（覆盖所有姓氏和常用名字用字）
+// PinyinMap 汉字到拼音的映射（覆盖所有姓氏、常用名字用字及地名、街道与小区用字）
 var PinyinMap = map[rune]string{
 	// === 常见姓氏 ===
 	'李': "li", '王': "wang", '张': "zhang", '刘': "liu", '陈': "chen",
@@ -23,6 +23,7 @@ var PinyinMap = map[rune]string{
 	'毛': "mao", '常': "chang", '万': "wan", '顾': "gu", '赖': "lai",
 	'武': "wu", '康': "kang", '贺': "he", '严': "yan", '尹': "yin",
 	'钱': "qian", '施': "shi", '牛': "niu", '洪': "hong", '龚': "gong",
+	'肖': "xiao", '付': "fu", '闫': "yan", '於': "yu",
 
 	// === 复姓 ===
 	'欧': "ou", '阳': "yang",
@@ -75,4 +76,352 @@ var PinyinMap = map[rune]string{
 	'喜': "xi", '财': "cai", '吉': "ji", '祥': "xiang", '庆': "qing",
 	'乐': "le", '嘉': "jia", '佳': "jia", '宝': "bao", '贝': "bei",
 	'正': "zheng", '清': "qing", '远': "yuan", '行': "xing", '道': "dao",
+
+	// === 地名、街道与小区用字 ===
+	'街': "jie", '西': "xi", '北': "bei", '路': "lu", '前': "qian",
+	'进': "jin", '向': "xiang", '风': "feng", '门': "men", '环': "huan",
+	'兴': "xing", '关': "guan", '城': "cheng", '郊': "jiao", '苑': "yuan",
+	'人': "ren", '民': "min", '解': "jie", '放': "fang", '胜': "sheng",
+	'利': "li", '和': "he", '团': "tuan", '结': "jie", '主': "zhu",
+	'生': "sheng", '友': "you", '谊': "yi", '光': "guang", '劳': "lao",
+	'动': "dong", '青': "qing", '年': "nian", '工': "gong", '农': "nong",
+	'八': "ba", '一': "yi", '设': "she", '发': "fa", '展': "zhan",
+	'振': "zhen", '复': "fu", '创': "chuang", '业': "ye", '繁': "fan",
+	'旺': "wang", '开': "kai", '改': "gai", '革': "ge", '步': "bu",
+	'奋': "fen", '崛': "jue", '起': "qi", '腾': "teng", '启': "qi",
+	'山': "shan", '族': "zu", '央': "yang", '共': "gong", '统': "tong",
+	'幸': "xing", '如': "ru", '意': "yi", '太': "tai", '长': "chang",
+	'居': "ju", '裕': "yu", '定': "ding", '泰': "tai", '顺': "shun",
+	'通': "tong", '化': "hua", '科': "ke", '技': "ji", '府': "fu",
+	'书': "shu", '院': "yuan", '育': "yu", '才': "cai", '智': "zhi",
+	'教': "jiao", '艺': "yi", '术': "shu", '知': "zhi", '识': "shi",
+	'状': "zhuang", '元': "yuan", '香': "xiang", '墨': "mo", '弘': "hong",
+	'求': "qiu", '励': "li", '滨': "bin", '河': "he", '湖': "hu",
+	'临': "lin", '望': "wang", '沿': "yan", '水': "shui", '绿': "lv",
+	'泉': "quan", '碧': "bi", '翠': "cui", '玄': "xuan", '竹': "zhu",
+	'梧': "wu", '桐': "tong", '银': "yin", '杏': "xing", '樟': "zhang",
+	'柏': "bai", '柳': "liu", '槐': "huai", '树': "shu", '榕': "rong",
+	'花': "hua", '垂': "chui", '枫': "feng", '橡': "xiang", '榆': "yu",
+	'荫': "yin", '杉': "shan", '桃': "tao", '梨': "li", '荷': "he",
+	'菊': "ju", '玫': "mei", '瑰': "gui", '牡': "mu", '丹': "dan",
+	'茉': "mo", '百': "bai", '合': "he", '棠': "tang", '樱': "ying",
+	'季': "ji", '芙': "fu", '鹃': "juan", '芍': "shao", '药': "yao",
+	'仙': "xian", '日': "ri", '秋': "qiu", '实': "shi", '冬': "dong",
+	'四': "si", '朝': "chao", '星': "xing", '曙': "shu", '晖': "hui",
+	'晚': "wan", '暮': "mu", '昏': "hun", '二': "er", '三': "san",
+	'五': "wu", '第': "di", '六': "liu", '七': "qi", '九': "jiu",
+	'十': "shi", '号': "hao", '商': "shang", '贸': "mao", '融': "rong",
+	'经': "jing", '济': "ji", '易': "yi", '园': "yuan", '产': "chan",
+	'市': "shi", '场': "chang", '集': "ji", '电': "dian", '物': "wu",
+	'流': "liu", '会': "hui", '广': "guang", '告': "gao", '外': "wai",
+	'火': "huo", '车': "che", '站': "zhan", '机': "ji", '港': "gang",
+	'口': "kou", '码': "ma", '头': "tou", '铁': "tie", '空': "kong",
+	'地': "di", '速': "su", '快': "kuai", '轨': "gui", '枢': "shu",
+	'纽': "niu", '汽': "qi", '客': "ke", '运': "yun", '货': "huo",
+	'古': "gu", '老': "lao", '衙': "ya", '墙': "qiang", '护': "hu",
+	'镇': "zhen", '庙': "miao", '巷': "xiang", '贡': "gong", '御': "yu",
+	'隍': "huang", '帝': "di", '后': "hou", '妈': "ma", '祖': "zu",
+	'旗': "qi", '彩': "cai", '虹': "hong", '色': "se", '凤': "feng",
+	'凰': "huang", '麒': "qi", '麟': "lin", '鹤': "he", '卧': "wo",
+	'鸣': "ming", '归': "gui", '雁': "yan", '虎': "hu", '跃': "yue",
+	'狮': "shi", '鸡': "ji", '好': "hao", '希': "xi", '想': "xiang",
+	'丰': "feng", '恒': "heng", '庐': "lu", '嵩': "song", '衡': "heng",
+	'峨': "e", '眉': "mei", '当': "dang", '昆': "kun", '仑': "lun",
+	'台': "tai", '普': "pu", '陀': "tuo", '荡': "dang", '夷': "yi",
+	'淮': "huai", '汉': "han", '湘': "xiang", '赣': "gan", '闽': "min",
+	'辽': "liao", '塘': "tang", '陵': "ling", '岷': "min", '京': "jing",
+	'州': "zhou", '深': "shen", '圳': "zhen", '杭': "hang", '都': "du",
+	'重': "chong", '津': "jin", '澳': "ao", '湾': "wan", '岛': "dao",
+	'连': "lian", '厦': "sha", '无': "wu", '锡': "xi", '沙': "sha",
+	'哈': "ha", '尔': "er", '川': "chuan", '拉': "la", '萨': "sa",
+	'忠': "zhong", '诚': "cheng", '仁': "ren", '礼': "li", '义': "yi",
+	'信': "xin", '善': "shan", '勤': "qin", '敢': "gan", '廉': "lian",
+	'节': "jie", '俭': "jian", '奉': "feng", '献': "xian", '敬': "jing",
+	'隆': "long", '盛': "sheng", '鼎': "ding", '升': "sheng", '同': "tong",
+	'协': "xie", '联': "lian", '作': "zuo", '齐': "qi", '享': "xiang",
+	'茵': "yin", '草': "cao", '坪': "ping", '植': "zhi", '游': "you",
+	'态': "tai", '洲': "zhou", '总': "zong", '部': "bu", '核': "he",
+	'能': "neng", '数': "shu", '息': "xi", '互': "hu", '计': "ji",
+	'算': "suan", '据': "ju", '区': "qu", '块': "kuai", '链': "lian",
+	'网': "wang", '楼': "lou", '写': "xie", '字': "zi", '办': "ban",
+	'酒': "jiu", '店': "dian", '宾': "bin", '馆': "guan", '蓝': "lan",
+	'岸': "an", '渔': "yu", '景': "jing", '霄': "xiao", '际': "ji",
+	'微': "wei", '细': "xi", '润': "run", '甘': "gan", '霖': "lin",
+	'孵': "fu", '研': "yan", '圈': "quan", '购': "gou", '批': "pi",
+	'零': "ling", '售': "shou", '专': "zhuan", '卖': "mai", '医': "yi",
+	'卫': "wei", '保': "bao", '养': "yang", '体': "ti", '身': "shen",
+	'竞': "jing", '奥': "ao", '球': "qiu", '泳': "yong", '务': "wu",
+	'寺': "si", '佛': "fo", '观': "guan", '音': "yin", '弥': "mi",
+	'真': "zhen", '堂': "tang", '桥': "qiao", '立': "li", '交': "jiao",
+	'架': "jia", '跨': "kua", '过': "guo", '侧': "ce", '塔': "ta",
+	'鼓': "gu", '阁': "ge", '庄': "zhuang", '果': "guo", '菜': "cai",
+	'圃': "pu", '苗': "miao", '茶': "cha", '稻': "dao", '校': "xiao",
+	'幼': "you", '儿': "er", '附': "fu", '验': "yan", '师': "shi",
+	'播': "bo", '视': "shi", '传': "chuan", '媒': "mei", '讯': "xun",
+	'络': "luo", '力': "li", '源': "yuan", '供': "gong", '油': "you",
+	'气': "qi", '矿': "kuang", '煤': "mei", '采': "cai", '冶': "ye",
+	'拥': "yong", '双': "shuang", '防': "fang", '雄': "xiong", '烈': "lie",
+	'士': "shi", '净': "jing", '循': "xun", '软': "ruan", '件': "jian",
+	'硬': "ying", '芯': "xin", '片': "pian", '半': "ban", '导': "dao",
+	'证': "zheng", '券': "quan", '险': "xian", '基': "ji", '投': "tou",
+	'资': "zi", '理': "li", '房': "fang", '盘': "pan", '住': "zhu",
+	'宅': "zhai", '别': "bie", '墅': "shu", '寓': "yu", '旅': "lv",
+	'度': "du", '假': "jia", '休': "xiu", '闲': "xian", '名': "ming",
+	'食': "shi", '餐': "can", '饮': "yin", '吃': "chi", '夜': "ye",
+	'烧': "shao", '烤': "kao", '锅': "guo", '黑': "hei", '浙': "zhe",
+	'徽': "hui", '陕': "shan", '肃': "su", '内': "nei", '蒙': "meng",
+	'藏': "zang", '疆': "jiang", '故': "gu", '坛': "tan", '颐': "yi",
+	'圆': "yuan", '俑': "yong", '始': "shi", '皇': "huang", '池': "chi",
+	'灵': "ling", '隐': "yin", '断': "duan", '堤': "di", '滩': "tan",
+	'豫': "yu", '越': "yue", '祠': "ci", '面': "mian", '非': "fei",
+	'荀': "xun", '屈': "qu", '原': "yuan", '迁': "qian", '班': "ban",
+	'固': "gu", '操': "cao", '备': "bei", '权': "quan", '羽': "yu",
+	'轼': "shi", '辛': "xin", '弃': "qi", '疾': "ji", '岳': "yue",
+	'功': "gong", '则': "ze", '漠': "mo", '戈': "ge", '壁': "bi",
+	'丘': "qiu", '盆': "pen", '峡': "xia", '谷': "gu", '溶': "rong",
+	'洞': "dong", '温': "wen", '瀑': "pu", '布': "bu", '湿': "shi",
+	'涂': "tu", '礁': "jiao", '屿': "yu", '辰': "chen", '斗': "dou",
+	'狼': "lang", '织': "zhi", '女': "nv", '郎': "lang", '彗': "hui",
+	'座': "zuo", '宙': "zhou", '嫦': "chang", '娥': "e", '羿': "yi",
+	'夸': "kua", '父': "fu", '精': "jing", '娲': "wa", '伏': "fu",
+	'羲': "xi", '神': "shen", '炎': "yan", '棋': "qi", '画': "hua",
+	'雕': "diao", '塑': "su", '陶': "tao", '舞': "wu", '蹈': "dao",
+	'戏': "xi", '剧': "ju", '影': "ying", '摄': "she", '版': "ban",
+	'素': "su", '描': "miao", '楚': "chu", '辞': "ci", '赋': "fu",
+	'词': "ci", '曲': "qu", '说': "shuo", '散': "san", '杂': "za",
+	'论': "lun", '庸': "yong", '孝': "xiao", '悌': "ti", '耻': "chi",
+	'良': "liang", '恭': "gong", '谦': "qian", '让': "rang", '笔': "bi",
+	'纸': "zhi", '砚': "yan", '宣': "xuan", '歙': "she", '洮': "tao",
+	'澄': "cheng", '泥': "ni", '烟': "yan", '井': "jing", '螺': "luo",
+	'袍': "pao", '洱': "er", '针': "zhen", '尖': "jian", '瓜': "gua",
+	'雾': "wu", '丝': "si", '绸': "chou", '锦': "jin", '绣': "xiu",
+	'绫': "ling", '缎': "duan", '缂': "ke", '刺': "ci", '蜀': "shu",
+	'瓷': "ci", '釉': "you", '里': "li", '粉': "fen", '珐': "fa",
+	'琅': "lang", '翡': "fei", '玛': "ma", '瑙': "nao", '少': "shao",
+	'崆': "kong", '峒': "dong", '本': "ben", '伤': "shang", '寒': "han",
+	'匮': "kui", '病': "bing", '灸': "jiu", '推': "tui", '拿': "na",
+	'拔': "ba", '罐': "guan", '艾': "ai", '膏': "gao", '米': "mi",
+	'麦': "mai", '粱': "liang", '豆': "dou", '棉': "mian", '麻': "ma",
+	'蚕': "can", '漆': "qi", '器': "qi", '牙': "ya", '编': "bian",
+	'藤': "teng", '蜡': "la", '染': "ran", '扎': "zha", '宵': "xiao",
+	'午': "wu", '夕': "xi", '腊': "la", '除': "chu", '旦': "dan",
+	'鼠': "shu", '丑': "chou", '寅': "yin", '卯': "mao", '兔': "tu",
+	'巳': "si", '蛇': "she", '未': "wei", '羊': "yang", '申': "shen",
+	'猴': "hou", '酉': "you", '戌': "xu", '狗': "gou", '亥': "hai",
+	'猪': "zhu", '乾': "qian", '坎': "kan", '离': "li", '震': "zhen",
+	'巽': "xun", '艮': "gen", '兑': "dui", '极': "ji", '两': "liang",
+	'仪': "yi", '象': "xiang", '卦': "gua", '土': "tu", '阴': "yin",
+	'税': "shui", '自': "zi", '惊': "jing", '蛰': "zhe", '分': "fen",
+	'满': "man", '芒': "mang", '种': "zhong", '至': "zhi", '暑': "shu",
+	'处': "chu", '霜': "shuang", '降': "jiang", '卓': "zhuo", '出': "chu",
+	'煌': "huang", '璀': "cui", '璨': "can", '闪': "shan", '耀': "yao",
+	'灿': "can", '烂': "lan", '宏': "hong", '图': "tu", '愿': "yuan",
+	'涟': "lian", '漪': "yi", '浪': "lang", '潮': "chao", '汐': "xi",
+	'涌': "yong", '峦': "luan", '群': "qun", '奇': "qi", '叠': "die",
+	'层': "ceng", '悬': "xuan", '崖': "ya", '峭': "qiao", '绝': "jue",
+	'岩': "yan", '偏': "pian", '扩': "kuo", '续': "xu", '筹': "chou",
+	'在': "zai", '竣': "jun", '完': "wan", '落': "luo", '凌': "ling",
+	'拂': "fu", '晓': "xiao", '破': "po", '早': "zao", '下': "xia",
+	'傍': "bang", '入': "ru", '欢': "huan", '愉': "yu", '活': "huo",
+	'详': "xiang", '淡': "dan", '泊': "bo", '迈': "mai", '情': "qing",
+	'壮': "zhuang", '聪': "cong", '圣': "sheng", '贤': "xian", '蒸': "zheng",
+	'蓬': "peng", '勃': "bo", '洽': "qia", '汇': "hui", '相': "xiang",
+	'调': "diao", '配': "pei", '拍': "pai", '默': "mo", '契': "qi",
+	'优': "you", '唯': "wei", '桷': "jue", '法': "fa", '檀': "tan",
+	'柚': "you", '桦': "hua", '来': "lai", '栀': "zhi", '夹': "jia",
+	'蝴': "hu", '蝶': "die", '君': "jun", '吊': "diao", '萝': "luo",
+	'掌': "zhang", '多': "duo", '肉': "rou", '葵': "kui", '薰': "xun",
+	'衣': "yi", '郁': "yu", '岗': "gang", '洼': "wa", '坡': "po",
+	'角': "jiao", '岬': "jia", '冲': "chong", '积': "ji", '扇': "shan",
+	'床': "chuang", '赤': "chi", '纬': "wei", '匠': "jiang", '瓦': "wa",
+	'坊': "fang", '磨': "mo", '铺': "pu", '铜': "tong", '砂': "sha",
+	'壶': "hu", '宜': "yi", '粤': "yue", '汴': "bian", '瓯': "ou",
+	'筝': "zheng", '琵': "pi", '琶': "pa", '笛': "di", '箫': "xiao",
+	'唢': "suo", '呐': "na", '扬': "yang", '阮': "ruan", '磬': "qing",
+	'锣': "luo", '钹': "bo", '尚': "shang", '记': "ji", '左': "zuo",
+	'治': "zhi", '晋': "jin", '隋': "sui", '代': "dai", '储': "chu",
+	'翊': "yi", '崇': "chong", '阜': "fu", '直': "zhi", '崂': "lao",
+	'指': "zhi", '庭': "ting", '鄱': "po", '巢': "chao", '千': "qian",
+	'滇': "dian", '抚': "fu", '洛': "luo", '镐': "gao", '咸': "xian",
+	'寨': "zhai", '沟': "gou", '界': "jie", '漓': "li", '格': "ge",
+	'廊': "lang", '拱': "gong", '浮': "fu", '渤': "bo", '印': "yin",
+	'冰': "bing", '亚': "ya", '俄': "e", '斯': "si", '加': "jia",
+	'巴': "ba", '坦': "tan", '伊': "yi", '朗': "lang", '黎': "li",
+	'伦': "lun", '敦': "dun", '约': "yue", '首': "shou", '迪': "di",
+	'拜': "bai", '悉': "xi", '尼': "ni", '属': "shu", '钢': "gang",
+	'铝': "lv", '玻': "bo", '璃': "li", '材': "cai", '沥': "li",
+	'料': "liao", '胶': "jiao", '纤': "xian", '维': "wei", '碳': "tan",
+	'铂': "bo", '钯': "ba", '铑': "lao", '钻': "zuan", '瑚': "hu",
+	'琥': "hu", '珀': "po", '纪': "ji", '馨': "xin", '谐': "xie",
+	'荆': "jing", '致': "zhi", '逸': "yi", '村': "cun", '尊': "zun",
+	'典': "dian", '亭': "ting", '听': "ting", '揽': "lan", '迎': "ying",
+	'鹿': "lu", '现': "xian", '时': "shi", '绅': "shen", '澜': "lan",
+	'畔': "pan", '邸': "di", '壹': "yi", '贰': "er", '品': "pin",
+	'招': "zhao", '雍': "yong", '著': "zhu", '茂': "mao", '璞': "pu",
+	'瑅': "ti", '禾': "he", '樾': "yue", '置': "zhi", '的': "de",
+	'筑': "zhu", '侨': "qiao", '鹅': "e", '堡': "bao", '玖': "jiu",
+	'兆': "zhao", '倾': "qing", '叁': "san", '肆': "si", '伍': "wu",
+	'柒': "qi", '栖': "qi", '聚': "ju", '茗': "ming", '赏': "shang",
+	'读': "du", '岭': "ling", '依': "yi", '枕': "zhen", '涧': "jian",
+	'瑟': "se", '轻': "qing", '稀': "xi", '暖': "nuan", '爽': "shuang",
+	'凉': "liang", '岁': "sui", '间': "jian", '境': "jing", '牧': "mu",
+	'歌': "ge", '乡': "xiang", '野': "ye", '舟': "zhou", '唱': "chang",
+	'篱': "li", '顶': "ding", '级': "ji", '奢': "she", '室': "shi",
+	'胄': "zhou", '派': "pai", '凡': "fan", '踪': "zong", '低': "di",
+	'边': "bian", '脚': "jiao", '私': "si", '塾': "shu", '儒': "ru",
+	'事': "shi", '盈': "ying", '照': "zhao", '有': "you", '全': "quan",
+	'先': "xian", '之': "zhi", '摩': "mo", '登': "deng", '社': "she",
+	'众': "zhong", '点': "dian", '瀚': "han", '媚': "mei", '盎': "ang",
+	'近': "jin", '式': "shi", '期': "qi", '禧': "xi", '授': "shou",
+	'者': "zhe", '员': "yuan", '冠': "guan", '将': "jiang", '臻': "zhen",
+	'橙': "cheng", '漫': "man", '甜': "tian", '蜜': "mi", '舒': "shu",
+	'怀': "huai", '呵': "he", '宠': "chong", '旧': "jiu", '简': "jian",
+	'排': "pai", '拼': "pin", '独': "du", '错': "cuo", '透': "tou",
+	'蔷': "qiang", '弦': "xian", '律': "lv", '企': "qi", '领': "ling",
+	'管': "guan", '裁': "cai", '呈': "cheng", '鸟': "niao", '踞': "ju",
+	'岐': "qi", '鱼': "yu", '雀': "que", '莲': "lian", '椿': "chun",
+	'莱': "lai", '瀛': "ying", '丈': "zhang", '话': "hua", '域': "yu",
+	'熙': "xi", '载': "zai", '宴': "yan", '辇': "nian", '虢': "guo",
+	'夫': "fu", '簪': "zan", '仕': "shi", '捣': "dao", '练': "lian",
+	'柴': "chai", '暝': "ming", '终': "zhong", '辋': "wang", '弄': "long",
+	'埋': "mai", '霓': "ni", '裳': "shang", '弈': "yi", '对': "dui",
+	'围': "wei", '跳': "tiao", '茅': "mao", '粮': "liang", '液': "ye",
+	'汾': "fen", '泸': "lu", '窖': "jiao", '剑': "jian", '鲁': "lu",
+	'评': "ping", '腔': "qiang", '禅': "chan", '巨': "ju", '蟹': "xie",
+	'秤': "cheng", '蝎': "xie", '射': "she", '手': "shou", '羯': "jie",
+	'瓶': "ping", '骏': "jun", '亿': "yi", '阖': "he", '其': "qi",
+	'扶': "fu", '摇': "yao", '帆': "fan", '亨': "heng", '到': "dao",
+	'得': "de", '甲': "jia", '屋': "wu", '延': "yan", '益': "yi",
+	'命': "ming", '抖': "dou", '擞': "sou", '焕': "huan", '奕': "yi",
+	'童': "tong", '颜': "yan", '返': "fan", '还': "hai", '睦': "mu",
+	'衷': "zhong", '邦': "bang", '鸾': "luan", '耶': "ye", '省': "sheng",
+	'顿': "dun", '哥': "ge", '比': "bi", '芝': "zhi", '矶': "ji",
+	'啸': "xiao", '豹': "bao", '吼': "hou", '举': "ju", '莺': "ying",
+	'摘': "zhai", '捌': "ba", '拾': "shi", '硕': "shuo", '讲': "jiang",
+	'助': "zhu", '究': "jiu", '留': "liu", '足': "zu", '篮': "lan",
+	'乒': "ping", '乓': "pang", '径': "jing", '跆': "tai", '拳': "quan",
+	'响': "xiang", '奏': "zou", '谣': "yao", '滚': "gun", '爵': "jue",
+	'提': "ti", '他': "ta", '克': "ke", '芭': "ba", '标': "biao",
+	'探': "tan", '兹': "zi", '狐': "hu", '泼': "po", '言': "yan",
+	'阿': "a", '伯': "bo", '占': "zhan", '琉': "liu", '玺': "xi",
+	'榴': "liu", '橄': "gan", '榄': "lan", '母': "mu", '呢': "ne",
+	'绒': "rong", '毫': "hao", '祁': "qi", '沪': "hu", '苹': "ping",
+	'橘': "ju", '葡': "pu", '萄': "tao", '蕉': "jiao", '莓': "mei",
+	'菠': "bo", '荔': "li", '枝': "zhi", '眼': "yan", '卜': "bu",
+	'番': "fan", '茄': "qie", '苦': "ku", '粟': "su", '黍': "shu",
+	'菽': "shu", '稷': "ji", '荞': "qiao", '热': "re", '带': "dai",
+	'阔': "kuo", '混': "hun", '撒': "sa", '干': "gan", '库': "ku",
+	'乌': "wu", '沁': "qin", '浑': "hun", '帕': "pa", '羌': "qiang",
+	'盖': "gai", '潭': "tan", '涠': "wei", '钓': "diao", '澎': "peng",
+	'鲜': "xian", '怒': "nu", '沧': "cang", '渡': "du", '沱': "tuo",
+	'迦': "jia", '姑': "gu", '娘': "niang", '嘎': "ga", '聂': "nie",
+	'诺': "nuo", '湫': "jiao", '漈': "ji", '从': "cong", '惠': "hui",
+	'恩': "en", '韶': "shao", '猫': "mao", '量': "liang", '由': "you",
+	'限': "xian", '龟': "gui", '骄': "jiao", '淀': "dian", '柔': "rou",
+	'密': "mi", '坻': "chi", '蓟': "ji", '陉': "xing", '藁': "gao",
+	'栾': "luan", '县': "xian", '邑': "yi", '赞': "zan", '氏': "shi",
+	'妃': "fei", '甸': "dian", '滦': "luan", '遵': "zun", '邯': "han",
+	'郸': "dan", '丛': "cong", '肥': "fei", '漳': "zhang", '涉': "she",
+	'磁': "ci", '邢': "xing", '襄': "xiang", '尧': "yao", '宗': "zong",
+	'涞': "lai", '蠡': "li", '涿': "zhuo", '碑': "bei", '沽': "gu",
+	'蔚': "wei", '承': "cheng", '鹰': "ying", '营': "ying", '宽': "kuan",
+	'盐': "yan", '皮': "pi", '回': "hui", '骅': "hua", '次': "ci",
+	'厂': "chang", '霸': "ba", '冀': "ji", '枣': "zao", '饶': "rao",
+	'娄': "lou", '烦': "fan", '冈': "gang", '盂': "yu", '潞': "lu",
+	'党': "dang", '屯': "tun", '垣': "yuan", '朔': "shuo", '应': "ying",
+	'右': "you", '昔': "xi", '遥': "yao", '介': "jie", '猗': "yi",
+	'闻': "wen", '绛': "jiang", '芮': "rui", '忻': "xin", '峙': "zhi",
+	'岢': "ke", '沃': "wo", '翼': "yi", '隰': "xi", '蒲': "pu",
+	'霍': "huo", '呼': "hu", '特': "te", '赛': "sai", '罕': "han",
+	'托': "tuo", '包': "bao", '拐': "guai", '鄂': "e", '什': "shi",
+	'翁': "weng", '喀': "ka", '喇': "la", '敖': "ao", '奈': "nai",
+	'勒': "le", '准': "zhun", '审': "shen", '赉': "lai", '莫': "mo",
+	'斡': "wo", '额': "e", '纳': "na", '根': "gen", '彦': "yan",
+	'淖': "nao", '磴': "deng", '察': "cha", '盟': "meng", '突': "tu",
+	'穆': "mu", '仆': "pu", '镶': "xiang", '鞍': "an", '岫': "xiu",
+	'芬': "fen", '桓': "huan", '鲅': "ba", '彰': "zhang", '弓': "gong",
+	'灯': "deng", '票': "piao", '葫': "hu", '芦': "lu", '绥': "sui",
+	'船': "chuan", '蛟': "jiao", '磐': "pan", '靖': "jing", '们': "men",
+	'珲': "hui", '昂': "ang", '碾': "nian", '讷': "ne", '滴': "di",
+	'鸭': "ya", '肇': "zhao", '汤': "tang", '箐': "qing", '岔': "cha",
+	'棱': "leng", '逊': "xun", '嫩': "nen", '奎': "kui", '浦': "pu",
+	'闵': "min", '邺': "ye", '溧': "li", '淳': "chun", '沛': "pei",
+	'睢': "sui", '沂': "yi", '邳': "pi", '熟': "shu", '仓': "cang",
+	'皋': "gao", '灌': "guan", '盱': "xu", '眙': "yi", '邗': "han",
+	'征': "zheng", '邮': "you", '徒': "tu", '句': "ju", '堰': "yan",
+	'宿': "su", '沭': "shu", '泗': "si", '鄞': "yin", '慈': "ci",
+	'苍': "cang", '浔': "xun", '绍': "shao", '柯': "ke", '虞': "yu",
+	'暨': "ji", '嵊': "sheng", '婺': "wu", '衢': "qu", '岱': "dai",
+	'椒': "jiao", '缙': "jin", '遂': "sui", '畲': "she", '芜': "wu",
+	'镜': "jing", '鸠': "jiu", '弋': "yi", '沚': "zhi", '为': "wei",
+	'蚌': "bang", '埠': "bu", '禹': "yu", '庵': "an", '含': "han",
+	'濉': "sui", '枞': "cong", '潜': "qian", '黟': "yi", '滁': "chu",
+	'琊': "ya", '谯': "qiao", '颍': "ying", '埇': "yong", '砀': "dang",
+	'璧': "bi", '亳': "bo", '涡': "wo", '泾': "jing", '绩': "ji",
+	'旌': "jing", '尾': "wei", '莆': "pu", '厢': "xiang", '尤': "you",
+	'鲤': "li", '芗': "xiang", '诏': "zhao", '汀': "ting", '屏': "ping",
+	'柘': "zhe", '谱': "pu", '栗': "li", '濂': "lian", '桑': "sang",
+	'修': "xiu", '渝': "yu", '章': "zhang", '犹': "you", '寻': "xun",
+	'铅': "qian", '横': "heng", '历': "li", '即': "ji", '淄': "zi",
+	'峄': "yi", '滕': "teng", '垦': "ken", '罘': "fu", '牟': "mou",
+	'潍': "wei", '朐': "qu", '兖': "yan", '汶': "wen", '乳': "ru",
+	'莒': "ju", '郯': "tan", '费': "fei", '聊': "liao", '茌': "chi",
+	'莘': "shen", '沾': "zhan", '棣': "di", '菏': "he", '单': "dan",
+	'郓': "yun", '鄄': "juan", '巩': "gong", '荥': "xing", '封': "feng",
+	'符': "fu", '杞': "qi", '尉': "wei", '考': "kao", '瀍': "chan",
+	'偃': "yan", '汝': "ru", '湛': "zhan", '郏': "jia", '殷': "yin",
+	'滑': "hua", '淇': "qi", '浚': "jun", '获': "huo", '焦': "jiao",
+	'陟': "zhi", '濮': "pu", '鄢': "yan", '漯': "luo", '郾': "yan",
+	'召': "zhao", '渑': "mian", '宛': "wan", '淅': "xi", '浉': "shi",
+	'潢': "huang", '项': "xiang", '驻': "zhu", '驿': "yi", '舆': "yu",
+	'确': "que", '泌': "mi", '硚': "qiao", '陂': "bei", '塞': "sai",
+	'箭': "jian", '郧': "yun", '猇': "xiao", '秭': "zi", '樊': "fan",
+	'掇': "duo", '刀': "dao", '感': "gan", '悟': "wu", '滋': "zi",
+	'监': "jian", '浠': "xi", '蕲': "qi", '穴': "xue", '随': "sui",
+	'麓': "lu", '浏': "liu", '株': "zhu", '淞': "song", '渌': "lu",
+	'攸': "you", '醴': "li", '耒': "lei", '汨': "mi", '澧': "li",
+	'赫': "he", '沅': "yuan", '郴': "chen", '冷': "leng", '牌': "pai",
+	'溆': "xu", '晃': "huang", '侗': "dong", '芷': "zhi", '底': "di",
+	'埔': "pu", '禺': "yu", '增': "zeng", '浈': "zhen", '汕': "shan",
+	'濠': "hao", '要': "yao", '莞': "guan", '揭': "jie", '邕': "yong",
+	'各': "ge", '圩': "xu", '岑': "cen", '钦': "qin", '覃': "tan",
+	'绵': "mian", '那': "na", '昭': "zhao", '仫': "mu", '佬': "lao",
+	'等': "deng", '凭': "ping", '琼': "qiong", '涯': "ya", '儋': "dan",
+	'涪': "fu", '坝': "ba", '碚': "bei", '綦': "qi", '黔': "qian",
+	'潼': "tong", '垫': "dian", '巫': "wu", '柱': "zhu", '郫': "pi",
+	'邛': "qiong", '崃': "lai", '攀': "pan", '叙': "xu", '蔺': "lin",
+	'邡': "fang", '梓': "zi", '犍': "jian", '沐': "mu", '彝': "yi",
+	'充': "chong", '陇': "long", '阆': "lang", '珙': "gong", '筠': "yun",
+	'邻': "lin", '蓥': "ying", '渠': "qu", '壤': "rang", '孜': "zi",
+	'孚': "fu", '炉': "lu", '拖': "tuo", '觉': "jue", '冕': "mian",
+	'烽': "feng", '仡': "ge", '湄': "mei", '习': "xi", '毕': "bi",
+	'阡': "qian", '贞': "zhen", '谟': "mo", '册': "ce", '秉': "bing",
+	'穗': "sui", '匀': "yun", '瓮': "weng", '劝': "quan", '傣': "dai",
+	'巧': "qiao", '蒗': "lang", '祜': "hu", '佤': "wa", '耿': "geng",
+	'谋': "mou", '个': "ge", '畴': "chou", '勐': "meng", '漾': "yang",
+	'濞': "bi", '巍': "wei", '颇': "po", '傈': "li", '僳': "su",
+	'堆': "dui", '卡': "ka", '仲': "zhong", '类': "lei", '脱': "tuo",
+	'隅': "yu", '乃': "nai", '囊': "nang", '措': "cuo", '查': "cha",
+	'索': "suo", '札': "zha", '噶': "ga", '灞': "ba", '鄠': "hu",
+	'渭': "wei", '旬': "xun", '勉': "mian", '略': "lve", '脂': "zhi",
+	'柞': "zha", '峪': "yu", '祝': "zhu", '掖': "ye", '宕': "dang",
+	'迭': "die", '碌': "lu", '湟': "huang", '晏': "yan", '久': "jiu",
+	'称': "cheng", '令': "ling", '茫': "mang", '峻': "jun", '嘴': "zui",
+	'吾': "wu", '坂': "ban", '碱': "jian", '吐': "tu", '鄯': "shan",
+	'垒': "lei", '楞': "leng", '轮': "lun", '犁': "li", '且': "qie",
+	'末': "mo", '焉': "yan", '耆': "qi", '恰': "qia", '疏': "shu",
+	'莎': "sha", '伽': "jia", '策': "ce", '蕴': "yun", '可': "ke",
+}
+
+// PinyinPhrases 多音字在地名中的特殊读音，转换时优先于逐字的 PinyinMap
+var PinyinPhrases = map[string]string{
+	"厦门": "xiamen", "厦大": "xiada", "六安": "luan", "六合": "luhe",
+	"蚌埠": "bengbu", "蚌山": "bengshan", "番禺": "panyu", "大埔": "dabu",
+	"乐清": "yueqing", "乐亭": "laoting", "长子": "zhangzi", "东阿": "donge",
+	"莘县": "shenxian", "歙县": "shexian", "铅山": "yanshan", "尉犁": "yuli",
+	"单县": "shanxian", "洪洞": "hongtong", "荥经": "yingjing", "涡阳": "guoyang",
+	"蔚县": "yuxian", "牟平": "muping", "中牟": "zhongmu", "浚县": "xunxian",
+	"枞阳": "zongyang", "黄陂": "huangpi", "犍为": "qianwei", "筠连": "junlian",
+	"珲春": "hunchun", "宕昌": "tanchang", "覃塘": "qintang", "吴堡": "wubu",
+	"泌阳": "biyang", "召陵": "shaoling",
 }
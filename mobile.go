@@ -0,0 +1,104 @@
+package chinaid
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// MobileInfo describes the segment of a mobile number.
+type MobileInfo struct {
+	Prefix   string  // matched segment prefix, e.g. "139" or "1703"
+	Carrier  Carrier // carrier, CarrierMVNO for virtual operator segments
+	Network  Carrier // carrier whose network serves the number; differs from Carrier only for MVNO segments
+	DataOnly bool    // data-only segment (data or IoT cards) that cannot make calls or receive SMS
+}
+
+// mobileSegmentIndex maps the prefixes of metadata.MobileSegments to their segments.
+var mobileSegmentIndex = func() map[string]*metadata.MobileSegment {
+	index := make(map[string]*metadata.MobileSegment)
+	for i := range metadata.MobileSegments {
+		index[metadata.MobileSegments[i].Prefix] = &metadata.MobileSegments[i]
+	}
+	return index
+}()
+
+// lookupMobileSegment returns the segment of mobile, matching 4-digit prefixes
+// before 3-digit ones.
+func lookupMobileSegment(mobile string) (*metadata.MobileSegment, bool) {
+	for _, l := range []int{4, 3} {
+		if len(mobile) < l {
+			continue
+		}
+		if s, ok := mobileSegmentIndex[mobile[:l]]; ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// segmentCarrier returns the carrier of s, CarrierMVNO for virtual operator segments.
+func segmentCarrier(s *metadata.MobileSegment) Carrier {
+	if s.MVNO {
+		return CarrierMVNO
+	}
+	return parseCarrier(s.Carrier)
+}
+
+func mobileError(err error, pos int) *ValidationError {
+	return &ValidationError{Field: FieldMobile, Position: pos, Err: err}
+}
+
+// ParseMobile identifies the carrier of an 11-digit mobile number by its
+// segment in metadata.MobileSegments.
+// It returns a *ValidationError wrapping ErrMobileLength, ErrMobileFormat or
+// ErrMobileSegment if the number is not 11 digits or matches no segment.
+func ParseMobile(mobile string) (*MobileInfo, error) {
+	if len(mobile) != 11 {
+		return nil, mobileError(ErrMobileLength, -1)
+	}
+	for i := 0; i < len(mobile); i++ {
+		if !isDigit(mobile[i]) {
+			return nil, mobileError(ErrMobileFormat, i)
+		}
+	}
+
+	s, ok := lookupMobileSegment(mobile)
+	if !ok {
+		return nil, mobileError(ErrMobileSegment, 0)
+	}
+	return &MobileInfo{
+		Prefix:   s.Prefix,
+		Carrier:  segmentCarrier(s),
+		Network:  parseCarrier(s.Carrier),
+		DataOnly: s.DataOnly,
+	}, nil
+}
+
+// LookupGeneratedMobileCity returns the city that the H-code (digits 4-7) of
+// mobile is allocated to in the simulated allocation used by PersonBuilder, so
+// for a number generated by this package it is the city of the Person, or
+// another city if MobileMismatch was set.
+//
+// It is not a home location (归属地) lookup: the allocation does not follow
+// the carriers' real H-code data, so real numbers get an arbitrary city and a
+// real 归属地 database will usually place generated numbers elsewhere.
+// It returns the errors of ParseMobile.
+func LookupGeneratedMobileCity(mobile string) (*Region, error) {
+	if _, err := ParseMobile(mobile); err != nil {
+		return nil, err
+	}
+	s, _ := lookupMobileSegment(mobile)
+	hcode, _ := strconv.Atoi(mobile[3:7])
+	ranges := hCodeRanges[s.Prefix]
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].to >= hcode })
+	return regionCodes[ranges[i].city], nil
+}
+
+// ValidateMobile reports whether mobile is an 11-digit number in a segment
+// that can make calls and receive SMS.
+func ValidateMobile(mobile string) bool {
+	info, err := ParseMobile(mobile)
+	return err == nil && !info.DataOnly
+}
@@ -0,0 +1,139 @@
+package chinaid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+func TestParseMobile(t *testing.T) {
+	tests := []struct {
+		mobile   string
+		prefix   string
+		carrier  Carrier
+		network  Carrier
+		dataOnly bool
+	}{
+		{"13912345678", "139", CarrierChinaMobile, CarrierChinaMobile, false},
+		{"13412345678", "1341", CarrierChinaMobile, CarrierChinaMobile, false},
+		{"13492345678", "1349", CarrierChinaTelecom, CarrierChinaTelecom, false},
+		{"18612345678", "186", CarrierChinaUnicom, CarrierChinaUnicom, false},
+		{"19912345678", "199", CarrierChinaTelecom, CarrierChinaTelecom, false},
+		{"19212345678", "192", CarrierChinaBroadnet, CarrierChinaBroadnet, false},
+		{"17031234567", "1703", CarrierMVNO, CarrierChinaMobile, false},
+		{"17001234567", "1700", CarrierMVNO, CarrierChinaTelecom, false},
+		{"17112345678", "171", CarrierMVNO, CarrierChinaUnicom, false},
+		{"16712345678", "167", CarrierMVNO, CarrierChinaUnicom, false},
+		{"14512345678", "145", CarrierChinaUnicom, CarrierChinaUnicom, true},
+		{"14401234567", "1440", CarrierChinaMobile, CarrierChinaMobile, true},
+	}
+	for _, tt := range tests {
+		info, err := ParseMobile(tt.mobile)
+		if err != nil {
+			t.Errorf("ParseMobile(%s) returned error: %v", tt.mobile, err)
+			continue
+		}
+		if info.Prefix != tt.prefix || info.Carrier != tt.carrier || info.Network != tt.network || info.DataOnly != tt.dataOnly {
+			t.Errorf("ParseMobile(%s) = %+v, want prefix %s carrier %v network %v data-only %t",
+				tt.mobile, *info, tt.prefix, tt.carrier, tt.network, tt.dataOnly)
+		}
+		if ValidateMobile(tt.mobile) == tt.dataOnly {
+			t.Errorf("ValidateMobile(%s) = %t, want %t", tt.mobile, !tt.dataOnly, !tt.dataOnly)
+		}
+	}
+}
+
+func TestParseMobileErrors(t *testing.T) {
+	tests := []struct {
+		mobile string
+		want   error
+		pos    int
+	}{
+		{"", ErrMobileLength, -1},
+		{"1391234567", ErrMobileLength, -1},
+		{"139123456789", ErrMobileLength, -1},
+		{"1391234a678", ErrMobileFormat, 7},
+		{"12012345678", ErrMobileSegment, 0},
+		{"14412345678", ErrMobileSegment, 0},
+		{"16012345678", ErrMobileSegment, 0},
+	}
+	for _, tt := range tests {
+		_, err := ParseMobile(tt.mobile)
+		var verr *ValidationError
+		if !errors.Is(err, tt.want) || !errors.As(err, &verr) {
+			t.Errorf("ParseMobile(%q) error = %v, want %v", tt.mobile, err, tt.want)
+			continue
+		}
+		if verr.Field != FieldMobile || verr.Position != tt.pos {
+			t.Errorf("ParseMobile(%q): ValidationError = %+v, want position %d", tt.mobile, verr, tt.pos)
+		}
+		if ValidateMobile(tt.mobile) {
+			t.Errorf("ValidateMobile(%q) = true, want false", tt.mobile)
+		}
+	}
+}
+
+func TestMobileSegmentsDisjoint(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, s := range metadata.MobileSegments {
+		if len(s.Prefix) != 3 && len(s.Prefix) != 4 {
+			t.Errorf("segment %s: prefix length should be 3 or 4", s.Prefix)
+		}
+		if seen[s.Prefix] {
+			t.Errorf("segment %s listed twice", s.Prefix)
+		}
+		seen[s.Prefix] = true
+		if parseCarrier(s.Carrier) == CarrierRandom {
+			t.Errorf("segment %s: unknown carrier %q", s.Prefix, s.Carrier)
+		}
+	}
+	for prefix := range seen {
+		if len(prefix) == 4 && seen[prefix[:3]] {
+			t.Errorf("segment %s is shadowed by %s", prefix, prefix[:3])
+		}
+	}
+	for _, prefix := range metadata.MobilePrefix {
+		if !ValidateMobile(prefix + "12345678") {
+			t.Errorf("MobilePrefix %s is not a voice segment", prefix)
+		}
+	}
+}
+
+func TestHCodeRangesCoverSegments(t *testing.T) {
+	for _, s := range metadata.MobileSegments {
+		ranges := hCodeRanges[s.Prefix]
+		from, to := 0, 9999
+		if len(s.Prefix) == 4 {
+			from = int(s.Prefix[3]-'0') * 1000
+			to = from + 999
+		}
+		if len(ranges) == 0 || ranges[0].from != from || ranges[len(ranges)-1].to != to {
+			t.Fatalf("segment %s: H-codes should cover %04d-%04d", s.Prefix, from, to)
+		}
+		for i, h := range ranges {
+			if h.to < h.from || (i > 0 && h.from != ranges[i-1].to+1) {
+				t.Fatalf("segment %s: H-code range %04d-%04d is empty or not contiguous", s.Prefix, h.from, h.to)
+			}
+			r, err := LookupRegion(h.city)
+			if err != nil || r.Level() != RegionCity {
+				t.Fatalf("segment %s: %s is not a city", s.Prefix, h.city)
+			}
+		}
+	}
+}
+
+func TestLookupGeneratedMobileCity(t *testing.T) {
+	h := cityHCodeRanges[[2]string{"139", "330100"}]
+	for _, hcode := range []int{h.from, h.to} {
+		mobile := fmt.Sprintf("139%04d1234", hcode)
+		r, err := LookupGeneratedMobileCity(mobile)
+		if err != nil || r.Code() != "330100" {
+			t.Errorf("LookupGeneratedMobileCity(%s) = %v, %v, want 杭州市", mobile, r, err)
+		}
+	}
+	if _, err := LookupGeneratedMobileCity("12012345678"); !errors.Is(err, ErrMobileSegment) {
+		t.Errorf("LookupGeneratedMobileCity(12012345678) error = %v, want ErrMobileSegment", err)
+	}
+}
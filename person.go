@@ -2,6 +2,9 @@ package chinaid
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mritd/chinaid/v2/metadata"
@@ -15,18 +18,18 @@ type Person struct {
 	firstName string
 	gender    Gender
 	birthday  time.Time
-	province  string
-	city      string
 	areaCode  string
-	address   string
+	address   Address
 	mobile    string
+	carrier   Carrier
+	landline  Landline
 	bankNo    string
 	email     string
 }
 
 // Getter methods
 
-// IDNo returns the ID card number.
+// IDNo returns the ID card number, 15 digits if the builder used LegacyIDNo.
 func (p *Person) IDNo() string { return p.idNo }
 
 // Name returns the full name.
@@ -41,34 +44,94 @@ func (p *Person) FirstName() string { return p.firstName }
 // Gender returns the gender.
 func (p *Person) Gender() Gender { return p.gender }
 
-// Birthday returns the birthday.
+// Birthday returns the birthday at midnight China Standard Time.
 func (p *Person) Birthday() time.Time { return p.birthday }
 
-// Age returns the age.
-func (p *Person) Age() int {
-	now := time.Now()
-	age := now.Year() - p.birthday.Year()
-	if now.YearDay() < p.birthday.YearDay() {
-		age--
+// Age returns the current age.
+func (p *Person) Age() int { return p.AgeAt(time.Now()) }
+
+// AgeAt returns the age on the date of t in China Standard Time.
+func (p *Person) AgeAt(t time.Time) int {
+	return calculateAge(p.birthday, t.In(chinaLocation))
+}
+
+// IsMinor reports whether the person is currently under 18.
+func (p *Person) IsMinor() bool { return p.AgeAt(time.Now()) < 18 }
+
+// IsAdultAt reports whether the person is 18 or older on the date of t.
+func (p *Person) IsAdultAt(t time.Time) bool { return p.AgeAt(t) >= 18 }
+
+// Zodiac returns the Chinese zodiac animal (生肖) of the lunar birth year, so
+// a person born before the Spring Festival has the animal of the previous
+// year. The Gregorian year is used if the birthday is outside 1900-2100.
+func (p *Person) Zodiac() Zodiac {
+	if d, err := SolarToLunar(p.birthday); err == nil {
+		return d.Zodiac()
+	}
+	return zodiacOfYear(p.birthday.Year())
+}
+
+// LunarBirthday returns the birthday in the lunar calendar (农历).
+// The zero LunarDate is returned if the birthday is outside 1900-2100.
+func (p *Person) LunarBirthday() LunarDate {
+	d, _ := SolarToLunar(p.birthday)
+	return d
+}
+
+// Constellation returns the Western zodiac sign (星座) of the birthday.
+func (p *Person) Constellation() Constellation {
+	return constellationOf(p.birthday.Month(), p.birthday.Day())
+}
+
+// Generation returns the birth decade bucket, e.g. "80后", "90后" or "00后".
+// The two-digit buckets are only used for births in 1950-2049, where they are
+// unambiguous; other births get the full decade, e.g. "1900年代".
+func (p *Person) Generation() string {
+	year := p.birthday.Year()
+	if year < 1950 || year >= 2050 {
+		return fmt.Sprintf("%d年代", year/10*10)
 	}
-	return age
+	return fmt.Sprintf("%02d后", year%100/10*10)
 }
 
 // Province returns the province name.
-func (p *Person) Province() string { return p.province }
+func (p *Person) Province() string { return p.address.Province }
 
-// City returns the city name.
-func (p *Person) City() string { return p.city }
+// City returns the prefecture-level city name, e.g. "杭州市". For the
+// municipalities it is the municipality itself, e.g. "北京市".
+func (p *Person) City() string { return p.address.City }
 
-// AreaCode returns the 6-digit area code.
+// District returns the current county-level district name, e.g. "西湖区".
+func (p *Person) District() string { return p.address.District }
+
+// AreaCode returns the 6-digit area code of the ID number. It is the code in
+// use in the birth year, which may since have been retired, e.g. "330125"
+// (余杭县) for a person of 余杭区 born in 1990.
 func (p *Person) AreaCode() string { return p.areaCode }
 
-// Address returns the full address.
-func (p *Person) Address() string { return p.address }
+// Address returns the full address, as formatted by Address.String.
+func (p *Person) Address() string { return p.address.String() }
+
+// AddressDetail returns the components of the address. Its AreaCode is the
+// current code of the district, which may differ from the AreaCode of the
+// ID number.
+func (p *Person) AddressDetail() Address { return p.address }
+
+// PostalCode returns the postal code of the district, e.g. "310013".
+func (p *Person) PostalCode() string { return regionCodes[p.address.AreaCode].postalCode }
 
 // Mobile returns the mobile phone number.
 func (p *Person) Mobile() string { return p.mobile }
 
+// MobileCarrier returns the carrier of the mobile number, CarrierMVNO for
+// virtual operator segments.
+func (p *Person) MobileCarrier() Carrier { return p.carrier }
+
+// Landline returns the fixed-line phone number, with an area code of the
+// person's city and an extension. Use its String, International and
+// WithExtension methods to format it.
+func (p *Person) Landline() Landline { return p.landline }
+
 // BankNo returns the bank card number.
 func (p *Person) BankNo() string { return p.bankNo }
 
@@ -77,13 +140,41 @@ func (p *Person) Email() string { return p.email }
 
 // PersonBuilder is a builder for creating Person instances.
 type PersonBuilder struct {
-	rng      *Rng
-	seed     int64
-	hasSeed  bool
-	province string
-	gender   Gender
-	minAge   int
-	maxAge   int
+	rng     *Rng
+	seed    int64
+	hasSeed bool
+	gender  Gender
+	minAge  int
+	maxAge  int
+	legacy  bool
+
+	hasBirthday  bool
+	birthdayFrom time.Time
+	birthdayTo   time.Time
+	refDate      time.Time
+
+	banks      []string
+	cardType   CardType
+	cardLength int
+
+	carriers       []Carrier
+	mobileMismatch bool
+
+	provinces        []string
+	provinceCodes    []string
+	cities           []string
+	areaCodes        []string
+	excludeProvinces []string
+	excludeCities    []string
+	excludeAreaCodes []string
+
+	sampling        Sampling
+	provinceWeights []float64 // indexed like metadata.Provinces
+
+	// birthdayErr and provinceWeightsErr record an invalid LunarBirthday or
+	// ProvinceWeights; validate reports them first.
+	birthdayErr        error
+	provinceWeightsErr error
 }
 
 // NewPerson creates a new PersonBuilder.
@@ -94,9 +185,50 @@ func NewPerson() *PersonBuilder {
 	}
 }
 
-// Province sets the province filter.
-func (b *PersonBuilder) Province(province string) *PersonBuilder {
-	b.province = province
+// Province restricts people to the given provinces. A name matches the full
+// name, short name or one-character abbreviation, e.g. "浙江省", "浙江" or "浙".
+func (b *PersonBuilder) Province(names ...string) *PersonBuilder {
+	b.provinces = names
+	return b
+}
+
+// ProvinceCode restricts people to the provinces with the given two-digit
+// codes, e.g. "33". It adds to the provinces given by Province.
+func (b *PersonBuilder) ProvinceCode(codes ...string) *PersonBuilder {
+	b.provinceCodes = codes
+	return b
+}
+
+// City restricts people to the given cities or districts, e.g. "杭州" or
+// "朝阳区". The administrative suffix (市, 区, 县, 州, 地区, ...) may be omitted.
+func (b *PersonBuilder) City(names ...string) *PersonBuilder {
+	b.cities = names
+	return b
+}
+
+// AreaCode restricts people to the given area codes. A six-digit code such as
+// "330106" matches exactly; a two- or four-digit code matches every area code
+// it prefixes.
+func (b *PersonBuilder) AreaCode(codes ...string) *PersonBuilder {
+	b.areaCodes = codes
+	return b
+}
+
+// ExcludeProvince excludes the given provinces, matched like Province.
+func (b *PersonBuilder) ExcludeProvince(names ...string) *PersonBuilder {
+	b.excludeProvinces = names
+	return b
+}
+
+// ExcludeCity excludes the given cities or districts, matched like City.
+func (b *PersonBuilder) ExcludeCity(names ...string) *PersonBuilder {
+	b.excludeCities = names
+	return b
+}
+
+// ExcludeAreaCode excludes the given area codes, matched like AreaCode.
+func (b *PersonBuilder) ExcludeAreaCode(codes ...string) *PersonBuilder {
+	b.excludeAreaCodes = codes
 	return b
 }
 
@@ -113,6 +245,120 @@ func (b *PersonBuilder) AgeRange(min, max int) *PersonBuilder {
 	return b
 }
 
+// BirthdayRange sets the inclusive birthday range [from, to], overriding AgeRange.
+// Only the calendar dates of from and to are used.
+func (b *PersonBuilder) BirthdayRange(from, to time.Time) *PersonBuilder {
+	b.birthdayErr = nil
+	b.hasBirthday = true
+	b.birthdayFrom = from
+	b.birthdayTo = to
+	return b
+}
+
+// Birthday sets an exact birthday, overriding AgeRange.
+func (b *PersonBuilder) Birthday(birthday time.Time) *PersonBuilder {
+	return b.BirthdayRange(birthday, birthday)
+}
+
+// LunarBirthday sets an exact birthday given as a lunar (农历) date, overriding
+// AgeRange. Build panics and TryBuild returns an error if the date does not
+// exist or is outside 1900-2100, unless a later call sets a valid birthday.
+func (b *PersonBuilder) LunarBirthday(date LunarDate) *PersonBuilder {
+	birthday, err := LunarToSolar(date)
+	if err != nil {
+		b.birthdayErr = fmt.Errorf("%w: lunar birthday %d-%d-%d (leap %t): %w",
+			ErrUnsatisfiable, date.Year, date.Month, date.Day, date.IsLeap, err)
+		return b
+	}
+	return b.Birthday(birthday)
+}
+
+// ReferenceDate sets the date used as "today" when deriving birthdays from
+// AgeRange and rejecting future birthdays. By default the current date is used;
+// fix it together with Seed to get the same output on any day and in any time zone.
+func (b *PersonBuilder) ReferenceDate(t time.Time) *PersonBuilder {
+	b.refDate = t
+	return b
+}
+
+// Sampling sets how provinces and cities are picked: uniformly (the default)
+// or weighted by census population.
+func (b *PersonBuilder) Sampling(s Sampling) *PersonBuilder {
+	b.sampling = s
+	return b
+}
+
+// ProvinceWeights sets custom province weights keyed by province name, matched
+// like Province, e.g. {"广东": 3, "北京": 1}; provinces not listed are never
+// picked. It takes precedence over Sampling for provinces, while cities within
+// the picked province still follow Sampling. Build panics and TryBuild
+// returns an error if a name is unknown or a weight is negative, unless a
+// later call sets valid weights.
+func (b *PersonBuilder) ProvinceWeights(weights map[string]float64) *PersonBuilder {
+	provinceWeights := make([]float64, len(metadata.Provinces))
+	for name, w := range weights {
+		i := provinceIndex(name)
+		if i < 0 {
+			b.provinceWeightsErr = fmt.Errorf("%w: unknown province %q in weights", ErrUnsatisfiable, name)
+			return b
+		}
+		if w < 0 {
+			b.provinceWeightsErr = fmt.Errorf("%w: negative weight %v for province %q", ErrUnsatisfiable, w, name)
+			return b
+		}
+		provinceWeights[i] += w
+	}
+	b.provinceWeights = provinceWeights
+	b.provinceWeightsErr = nil
+	return b
+}
+
+// LegacyIDNo makes IDNo return 15-digit first-generation ID numbers.
+// Birth years are limited to 1900-1999, the only years with a 15-digit form.
+func (b *PersonBuilder) LegacyIDNo() *PersonBuilder {
+	b.legacy = true
+	return b
+}
+
+// Bank restricts the bank card to the given issuing banks, e.g. "招商银行".
+// A name matches a bank in metadata.CardBins exactly, without its
+// "股份有限公司" suffix, or by a common full or short name such as
+// "中国工商银行" or "工行".
+func (b *PersonBuilder) Bank(names ...string) *PersonBuilder {
+	b.banks = names
+	return b
+}
+
+// CardType sets the bank card type.
+func (b *PersonBuilder) CardType(t CardType) *PersonBuilder {
+	b.cardType = t
+	return b
+}
+
+// CardLength sets the bank card number length, e.g. 16 or 19.
+func (b *PersonBuilder) CardLength(length int) *PersonBuilder {
+	b.cardLength = length
+	return b
+}
+
+// Carrier restricts the mobile number to segments of the given carriers.
+// CarrierMVNO selects the virtual operator segments, which the other carriers
+// do not include. Data-only segments are never used.
+func (b *PersonBuilder) Carrier(carriers ...Carrier) *PersonBuilder {
+	b.carriers = carriers
+	return b
+}
+
+// MobileMismatch makes the H-code (digits 4-7) of the mobile number one that
+// is allocated to a city other than the person's, as reported by
+// LookupGeneratedMobileCity. By default the H-code is allocated to the
+// person's city. The allocation is simulated and does not follow the
+// carriers' real home location (归属地) data.
+func (b *PersonBuilder) MobileMismatch() *PersonBuilder {
+	b.mobileMismatch = true
+	return b
+}
+
 // Seed sets the random seed for reproducibility.
 func (b *PersonBuilder) Seed(seed int64) *PersonBuilder {
 	b.seed = seed
@@ -121,7 +367,26 @@ func (b *PersonBuilder) Seed(seed int64) *PersonBuilder {
 }
 
 // Build generates a single Person.
+//
+// Build panics if the builder options cannot be satisfied, e.g. LegacyIDNo
+// with a birthday after 1999, an unknown Bank or City, or ProvinceWeights
+// that are all zero. Use TryBuild to get the error instead when the options
+// come from user input.
 func (b *PersonBuilder) Build() *Person {
+	p, err := b.TryBuild()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// TryBuild generates a single Person, returning an error wrapping
+// ErrUnsatisfiable if the builder options cannot be satisfied.
+func (b *PersonBuilder) TryBuild() (*Person, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+
 	if b.hasSeed {
 		b.rng = NewRngWithSeed(b.seed)
 	} else {
@@ -130,55 +395,516 @@ func (b *PersonBuilder) Build() *Person {
 
 	p := &Person{}
 
-	b.generateLocation(p)
 	b.generateGender(p)
 	b.generateBirthday(p)
+	b.generateLocation(p)
 	b.generateIDNo(p)
 	b.generateName(p)
 	b.generateAddress(p)
 	b.generateMobile(p)
 	b.generateBankNo(p)
 	b.generateEmail(p)
+	b.generateLandline(p)
 
-	return p
+	return p, nil
 }
 
 // BuildN generates multiple Person instances.
+//
+// BuildN panics if the builder options cannot be satisfied, like Build. Use
+// TryBuildN to get the error instead.
 func (b *PersonBuilder) BuildN(n int) []*Person {
+	persons, err := b.TryBuildN(n)
+	if err != nil {
+		panic(err)
+	}
+	return persons
+}
+
+// TryBuildN generates multiple Person instances, returning an error wrapping
+// ErrUnsatisfiable if the builder options cannot be satisfied.
+func (b *PersonBuilder) TryBuildN(n int) ([]*Person, error) {
 	persons := make([]*Person, n)
 	for i := 0; i < n; i++ {
-		builder := &PersonBuilder{
-			province: b.province,
-			gender:   b.gender,
-			minAge:   b.minAge,
-			maxAge:   b.maxAge,
-		}
+		builder := *b
 		if b.hasSeed {
 			builder.seed = b.seed + int64(i)
-			builder.hasSeed = true
 		}
-		persons[i] = builder.Build()
+		p, err := builder.TryBuild()
+		if err != nil {
+			return nil, err
+		}
+		persons[i] = p
 	}
-	return persons
+	return persons, nil
+}
+
+// validate checks that the builder options can be satisfied.
+func (b *PersonBuilder) validate() error {
+	if b.birthdayErr != nil {
+		return b.birthdayErr
+	}
+	if b.provinceWeightsErr != nil {
+		return b.provinceWeightsErr
+	}
+	if from, to := b.birthdayRange(b.now()); from.After(to) {
+		if b.legacy {
+			return fmt.Errorf("%w: no birthday in 1900-1999 for 15-digit ID numbers within the age or birthday range",
+				ErrUnsatisfiable)
+		}
+		return fmt.Errorf("%w: no birthday between %s and %s, or the range is in the future",
+			ErrUnsatisfiable, b.birthdayFrom.Format(time.DateOnly), b.birthdayTo.Format(time.DateOnly))
+	}
+	if _, err := b.locations(); err != nil {
+		return err
+	}
+	if _, err := b.cardBins(); err != nil {
+		return err
+	}
+	if _, err := b.mobileSegments(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// generateLocation generates location information.
+// mobileSegments returns the segments of metadata.MobileSegments that can make
+// calls and match the carrier options.
+func (b *PersonBuilder) mobileSegments() ([]*metadata.MobileSegment, error) {
+	for _, c := range b.carriers {
+		if c < CarrierRandom || c > CarrierMVNO {
+			return nil, fmt.Errorf("%w: unknown carrier %d", ErrUnsatisfiable, c)
+		}
+	}
+	var result []*metadata.MobileSegment
+	for i := range metadata.MobileSegments {
+		s := &metadata.MobileSegments[i]
+		if s.DataOnly {
+			continue
+		}
+		if len(b.carriers) > 0 && !slices.Contains(b.carriers, segmentCarrier(s)) && !slices.Contains(b.carriers, CarrierRandom) {
+			continue
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+// cardBins returns the entries of metadata.CardBins matching the bank card options.
+func (b *PersonBuilder) cardBins() ([]*metadata.CardBin, error) {
+	var banks []*metadata.CardBin
+	if len(b.banks) == 0 {
+		for i := range metadata.CardBins {
+			banks = append(banks, &metadata.CardBins[i])
+		}
+	}
+	for _, name := range b.banks {
+		matched, err := matchCardBins(name)
+		if err != nil {
+			return nil, err
+		}
+		banks = append(banks, matched...)
+	}
+
+	var result []*metadata.CardBin
+	for _, bank := range banks {
+		if b.cardType != CardTypeRandom && parseCardType(bank.CardType) != b.cardType {
+			continue
+		}
+		if b.cardLength != 0 && bank.Length != b.cardLength {
+			continue
+		}
+		result = append(result, bank)
+	}
+	if len(result) == 0 {
+		var conds []string
+		if len(b.banks) > 0 {
+			conds = append(conds, "bank "+strings.Join(b.banks, "/"))
+		}
+		if b.cardType != CardTypeRandom {
+			conds = append(conds, "card type "+b.cardType.String())
+		}
+		if b.cardLength != 0 {
+			conds = append(conds, "length "+strconv.Itoa(b.cardLength))
+		}
+		return nil, fmt.Errorf("%w: no bank card matches %s", ErrUnsatisfiable, strings.Join(conds, ", "))
+	}
+	return result, nil
+}
+
+// bankAliases maps full and short bank names to the names used in
+// metadata.CardBins.
+var bankAliases = map[string]string{
+	"中国工商银行": "工商银行", "工行": "工商银行",
+	"中国农业银行": "农业银行", "农行": "农业银行",
+	"中行":     "中国银行",
+	"中国建设银行": "建设银行", "建行": "建设银行",
+	"交行":       "交通银行",
+	"中国邮政储蓄银行": "邮储银行", "邮政储蓄银行": "邮储银行",
+	"招行":       "招商银行",
+	"上海浦东发展银行": "浦东发展银行", "浦发银行": "浦东发展银行",
+	"中国民生银行": "民生银行",
+	"中国光大银行": "光大银行",
+}
+
+// matchCardBins returns the entries of the bank named name. Names are
+// compared without the "股份有限公司" suffix, after resolving bankAliases. It
+// returns an error wrapping ErrUnsatisfiable if no bank or more than one bank
+// matches.
+func matchCardBins(name string) ([]*metadata.CardBin, error) {
+	key := strings.TrimSuffix(name, "股份有限公司")
+	if alias, ok := bankAliases[key]; ok {
+		key = alias
+	}
+	var matched []*metadata.CardBin
+	var names []string
+	for i := range metadata.CardBins {
+		bank := &metadata.CardBins[i]
+		if strings.TrimSuffix(bank.Name, "股份有限公司") != key {
+			continue
+		}
+		matched = append(matched, bank)
+		if !slices.Contains(names, bank.Name) {
+			names = append(names, bank.Name)
+		}
+	}
+	switch {
+	case len(names) == 0:
+		return nil, fmt.Errorf("%w: unknown bank %q", ErrUnsatisfiable, name)
+	case len(names) > 1:
+		return nil, fmt.Errorf("%w: bank %q is ambiguous: %s", ErrUnsatisfiable, name, strings.Join(names, ", "))
+	}
+	return matched, nil
+}
+
+// generateLocation generates location information. The area code is one that
+// was in use in the birth year, so people born before a district was set up
+// carry the retired code of the county it replaced.
 func (b *PersonBuilder) generateLocation(p *Person) {
-	if b.province != "" {
-		if prov, ok := metadata.ProvinceMap[b.province]; ok {
-			p.province = prov.Short
-			city := prov.Cities[b.rng.Intn(len(prov.Cities))]
-			p.city = city.Name
-			p.areaCode = city.AreaCodes[b.rng.Intn(len(city.AreaCodes))]
-			return
+	locations, _ := b.locations()
+	loc := b.pickProvince(locations)
+	city := b.pickCity(loc.cities)
+
+	year := p.birthday.Year()
+	district := city.districts[b.rng.Intn(len(city.districts))]
+	codes := areaCodesIn(district, year)
+	if len(codes) == 0 {
+		// The district did not exist yet; fall back to the districts of the
+		// same city that did, or keep its current code if there are none.
+		var existing []metadata.District
+		for _, d := range city.districts {
+			if len(areaCodesIn(d, year)) > 0 {
+				existing = append(existing, d)
+			}
+		}
+		if len(existing) > 0 {
+			district = existing[b.rng.Intn(len(existing))]
+			codes = areaCodesIn(district, year)
+		} else {
+			codes = []string{district.Code}
+		}
+	}
+	p.address = Address{
+		Province: loc.province.Short,
+		City:     city.city.Name,
+		District: district.Name,
+		AreaCode: district.Code,
+	}
+	p.areaCode = codes[b.rng.Intn(len(codes))]
+}
+
+// predecessorCodes maps current district codes to the retired codes they
+// took over, directly or through other retired codes.
+var predecessorCodes = func() map[string][]*metadata.HistoricalAreaCode {
+	m := make(map[string][]*metadata.HistoricalAreaCode)
+	for i := range metadata.HistoricalAreaCodes {
+		h := &metadata.HistoricalAreaCodes[i]
+		m[h.Current()] = append(m[h.Current()], h)
+	}
+	return m
+}()
+
+// areaCodesIn returns the codes of the district that were in use in year: its
+// own code once it was set up, and the retired codes it took over before that.
+func areaCodesIn(d metadata.District, year int) []string {
+	var codes []string
+	if d.Since <= year {
+		codes = append(codes, d.Code)
+	}
+	for _, h := range predecessorCodes[d.Code] {
+		if h.ValidIn(year) {
+			codes = append(codes, h.Code)
+		}
+	}
+	return codes
+}
+
+// provinceLocation is a province with the cities that pass the location filters.
+type provinceLocation struct {
+	index    int // index in metadata.Provinces
+	province *metadata.Province
+	cities   []cityLocation
+}
+
+// cityLocation is a city with the districts that pass the location filters.
+type cityLocation struct {
+	city      *metadata.City
+	districts []metadata.District
+}
+
+// locations returns the provinces, cities and districts that pass the
+// location filters, or an error wrapping ErrUnsatisfiable if a filter names an
+// unknown place or nothing is left.
+func (b *PersonBuilder) locations() ([]provinceLocation, error) {
+	if len(b.provinces)+len(b.provinceCodes)+len(b.cities)+len(b.areaCodes)+
+		len(b.excludeProvinces)+len(b.excludeCities)+len(b.excludeAreaCodes) == 0 && b.provinceWeights == nil {
+		return allLocations, nil
+	}
+
+	provinces, err := provinceSet(b.provinces, b.provinceCodes)
+	if err != nil {
+		return nil, err
+	}
+	excludeProvinces, err := provinceSet(b.excludeProvinces, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, names := range [][]string{b.cities, b.excludeCities} {
+		for _, name := range names {
+			if !cityExists(name) {
+				return nil, fmt.Errorf("%w: unknown city or district %q", ErrUnsatisfiable, name)
+			}
+		}
+	}
+	for _, codes := range [][]string{b.areaCodes, b.excludeAreaCodes} {
+		for _, code := range codes {
+			if !areaCodeExists(code) {
+				return nil, fmt.Errorf("%w: unknown area code %q", ErrUnsatisfiable, code)
+			}
+		}
+	}
+
+	result := b.filterLocations(provinces, excludeProvinces)
+	if len(result) == 0 {
+		if b.provinceWeights != nil {
+			return nil, fmt.Errorf("%w: no area with a positive province weight matches the location filters", ErrUnsatisfiable)
+		}
+		return nil, fmt.Errorf("%w: no area matches the location filters", ErrUnsatisfiable)
+	}
+	return result, nil
+}
+
+// filterLocations applies the location filters and province weights to
+// metadata.Provinces; provinces and excludeProvinces are indexed like it.
+func (b *PersonBuilder) filterLocations(provinces, excludeProvinces []bool) []provinceLocation {
+	var result []provinceLocation
+	for i := range metadata.Provinces {
+		prov := &metadata.Provinces[i]
+		if (provinces != nil && !provinces[i]) || (excludeProvinces != nil && excludeProvinces[i]) {
+			continue
+		}
+		if b.provinceWeights != nil && b.provinceWeights[i] == 0 {
+			continue
+		}
+
+		loc := provinceLocation{index: i, province: prov}
+		for j := range prov.Cities {
+			city := &prov.Cities[j]
+			if matchCity(city.Name, b.excludeCities) {
+				continue
+			}
+			// A name matching the city selects all of its districts, otherwise
+			// only the districts it names.
+			wholeCity := len(b.cities) == 0 || matchCity(city.Name, b.cities)
+			districts := city.Districts
+			if !wholeCity || len(b.excludeCities)+len(b.areaCodes)+len(b.excludeAreaCodes) > 0 {
+				districts = nil
+				for _, d := range city.Districts {
+					if !wholeCity && !matchCity(d.Name, b.cities) {
+						continue
+					}
+					if matchCity(d.Name, b.excludeCities) {
+						continue
+					}
+					if (len(b.areaCodes) > 0 && !matchAreaCode(d.Code, b.areaCodes)) || matchAreaCode(d.Code, b.excludeAreaCodes) {
+						continue
+					}
+					districts = append(districts, d)
+				}
+			}
+			if len(districts) > 0 {
+				loc.cities = append(loc.cities, cityLocation{city: city, districts: districts})
+			}
+		}
+		if len(loc.cities) > 0 {
+			result = append(result, loc)
+		}
+	}
+	return result
+}
+
+// allLocations holds every province, city and district, as returned by
+// locations when there are no location filters.
+var allLocations = (&PersonBuilder{}).filterLocations(nil, nil)
+
+// pickProvince picks one of locations honoring custom weights and Sampling.
+func (b *PersonBuilder) pickProvince(locations []provinceLocation) *provinceLocation {
+	var weights []float64
+	switch {
+	case b.provinceWeights != nil:
+		weights = make([]float64, len(locations))
+		for i, loc := range locations {
+			weights[i] = b.provinceWeights[loc.index]
+		}
+	case b.sampling == SamplingPopulation:
+		weights = make([]float64, len(locations))
+		for i, loc := range locations {
+			weights[i] = float64(loc.province.Population)
+		}
+	}
+	if weights != nil {
+		if i := b.rng.WeightedIndex(weights); i >= 0 {
+			return &locations[i]
+		}
+	}
+	return &locations[b.rng.Intn(len(locations))]
+}
+
+// pickCity picks one of cities according to Sampling.
+func (b *PersonBuilder) pickCity(cities []cityLocation) *cityLocation {
+	if b.sampling == SamplingPopulation {
+		weights := make([]float64, len(cities))
+		for i, city := range cities {
+			weights[i] = float64(city.city.Population)
+		}
+		if i := b.rng.WeightedIndex(weights); i >= 0 {
+			return &cities[i]
+		}
+	}
+	return &cities[b.rng.Intn(len(cities))]
+}
+
+// provinceIndex returns the index in metadata.Provinces of the province
+// named name (full name, short name or abbreviation), or -1.
+func provinceIndex(name string) int {
+	prov, ok := metadata.ProvinceMap[name]
+	if !ok {
+		return -1
+	}
+	for i := range metadata.Provinces {
+		if &metadata.Provinces[i] == prov {
+			return i
+		}
+	}
+	return -1
+}
+
+// provinceSet resolves province names and codes to a set indexed like
+// metadata.Provinces, or nil if there are none.
+func provinceSet(names, codes []string) ([]bool, error) {
+	if len(names) == 0 && len(codes) == 0 {
+		return nil, nil
+	}
+	set := make([]bool, len(metadata.Provinces))
+	for _, name := range names {
+		i := provinceIndex(name)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: unknown province %q", ErrUnsatisfiable, name)
+		}
+		set[i] = true
+	}
+	for _, code := range codes {
+		found := false
+		for i, prov := range metadata.Provinces {
+			if prov.Code == code {
+				set[i] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: unknown province code %q", ErrUnsatisfiable, code)
+		}
+	}
+	return set, nil
+}
+
+// citySuffixes are the administrative suffixes City names may omit.
+var citySuffixes = []string{"地区", "新区", "市", "区", "县", "州", "盟", "旗"}
+
+// matchCity reports whether the city or district called name is one of names,
+// with or without its administrative suffix.
+func matchCity(name string, names []string) bool {
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		if !strings.HasPrefix(name, n) {
+			continue
+		}
+		if len(name) == len(n) {
+			return true
+		}
+		for _, suffix := range citySuffixes {
+			if name[len(n):] == suffix {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cityExists reports whether any city or district in metadata.Provinces matches name.
+func cityExists(name string) bool {
+	names := []string{name}
+	for _, prov := range metadata.Provinces {
+		for _, city := range prov.Cities {
+			if matchCity(city.Name, names) {
+				return true
+			}
+			for _, d := range city.Districts {
+				if matchCity(d.Name, names) {
+					return true
+				}
+			}
 		}
 	}
+	return false
+}
 
-	prov := metadata.Provinces[b.rng.Intn(len(metadata.Provinces))]
-	p.province = prov.Short
-	city := prov.Cities[b.rng.Intn(len(prov.Cities))]
-	p.city = city.Name
-	p.areaCode = city.AreaCodes[b.rng.Intn(len(city.AreaCodes))]
+// areaCodePrefix trims the trailing "00" pairs of a province or city code, so
+// that "330000" and "330100" select everything under Zhejiang and Hangzhou.
+func areaCodePrefix(code string) string {
+	for len(code) > 2 && strings.HasSuffix(code, "00") {
+		code = code[:len(code)-2]
+	}
+	return code
+}
+
+// matchAreaCode reports whether the district code starts with one of codes.
+func matchAreaCode(code string, codes []string) bool {
+	for _, c := range codes {
+		if c != "" && strings.HasPrefix(code, areaCodePrefix(c)) {
+			return true
+		}
+	}
+	return false
+}
+
+// areaCodeExists reports whether code is a 2-, 4- or 6-digit code selecting
+// at least one known district.
+func areaCodeExists(code string) bool {
+	if len(code) != 2 && len(code) != 4 && len(code) != 6 {
+		return false
+	}
+	for _, prov := range metadata.Provinces {
+		for _, city := range prov.Cities {
+			for _, d := range city.Districts {
+				if matchAreaCode(d.Code, []string{code}) {
+					return true
+				}
+			}
+		}
+	}
+	return false
 }
 
 // generateGender generates gender.
@@ -194,17 +920,64 @@ func (b *PersonBuilder) generateGender(p *Person) {
 	}
 }
 
-// generateBirthday generates birthday.
-func (b *PersonBuilder) generateBirthday(p *Person) {
-	now := time.Now()
-	minYear := now.Year() - b.maxAge
-	maxYear := now.Year() - b.minAge
+// now returns the reference date in China Standard Time.
+func (b *PersonBuilder) now() time.Time {
+	if !b.refDate.IsZero() {
+		return b.refDate.In(chinaLocation)
+	}
+	return time.Now().In(chinaLocation)
+}
 
-	year := b.rng.IntRange(minYear, maxYear+1)
-	month := b.rng.IntRange(1, 13)
-	day := b.rng.IntRange(1, 29)
+// dateOf returns the calendar date of t at midnight UTC, for day arithmetic.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
 
-	p.birthday = time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+// yearsBefore returns the date n years before d; Feb 29 maps to Feb 28 in
+// non-leap years.
+func yearsBefore(d time.Time, n int) time.Time {
+	t := time.Date(d.Year()-n, d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	if t.Month() != d.Month() {
+		t = t.AddDate(0, 0, -t.Day())
+	}
+	return t
+}
+
+// birthdayRange returns the inclusive range of birthdays allowed by the options.
+// The range is empty (from after to) if the options cannot be satisfied.
+func (b *PersonBuilder) birthdayRange(now time.Time) (time.Time, time.Time) {
+	today := dateOf(now)
+
+	var from, to time.Time
+	if b.hasBirthday {
+		from, to = dateOf(b.birthdayFrom), dateOf(b.birthdayTo)
+	} else {
+		minAge, maxAge := min(b.minAge, b.maxAge), max(b.minAge, b.maxAge)
+		from = yearsBefore(today, maxAge+1).AddDate(0, 0, 1)
+		to = yearsBefore(today, minAge)
+	}
+	if to.After(today) {
+		to = today
+	}
+	if b.legacy {
+		if first := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC); from.Before(first) {
+			from = first
+		}
+		if last := time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC); to.After(last) {
+			to = last
+		}
+	}
+	return from, to
+}
+
+// generateBirthday generates a birthday uniformly distributed over the allowed dates.
+func (b *PersonBuilder) generateBirthday(p *Person) {
+	from, to := b.birthdayRange(b.now())
+	// Both are UTC midnights; time.Duration would overflow past 292 years.
+	days := int((to.Unix() - from.Unix()) / 86400)
+	d := from.AddDate(0, 0, b.rng.Intn(days+1))
+
+	p.birthday = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, chinaLocation)
 }
 
 // generateIDNo generates the ID card number.
@@ -221,22 +994,73 @@ func (b *PersonBuilder) generateIDNo(p *Person) {
 	idNo17 := fmt.Sprintf("%s%s%03d", p.areaCode, birthday, seqCode)
 	checkCode := calculateCheckCode(idNo17)
 	p.idNo = idNo17 + checkCode
+	if b.legacy {
+		p.idNo = toIDNo15(p.idNo)
+	}
 }
 
-// generateName generates the name.
-func (b *PersonBuilder) generateName(p *Person) {
-	if b.rng.Intn(100) < 97 { // 97% 单姓, 3% 复姓
-		p.lastName = b.rng.Choice(metadata.SingleLastName)
-	} else {
-		p.lastName = b.rng.Choice(metadata.CompoundLastName)
+// namePool is a list of distinct names with their sampling weights.
+type namePool struct {
+	names   []string
+	weights []float64
+}
+
+// pick returns a name chosen by weight.
+func (np *namePool) pick(rng *Rng) string {
+	return np.names[rng.WeightedIndex(np.weights)]
+}
+
+// newNamePool builds a pool of the distinct names of lists, weighted by
+// freq. Names missing from freq get fallback, or share what is left of 100%
+// equally if fallback is 0.
+func newNamePool(freq map[string]float64, fallback float64, lists ...[]string) *namePool {
+	np := &namePool{}
+	seen := make(map[string]bool)
+	listed, unlisted := 0.0, 0
+	for _, list := range lists {
+		for _, name := range list {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			np.names = append(np.names, name)
+			w, ok := freq[name]
+			if ok {
+				listed += w
+			} else {
+				unlisted++
+			}
+			np.weights = append(np.weights, w)
+		}
 	}
+	if fallback == 0 && unlisted > 0 {
+		fallback = (100 - listed) / float64(unlisted)
+	}
+	for i, name := range np.names {
+		if _, ok := freq[name]; !ok {
+			np.weights[i] = fallback
+		}
+	}
+	return np
+}
+
+// Name pools weighted by the frequencies in metadata, so that common names
+// such as 张伟 come up about as often as in the population.
+var (
+	lastNamePool = newNamePool(metadata.LastNameFrequency, metadata.RareLastNameFrequency,
+		metadata.SingleLastName, metadata.CompoundLastName)
+	maleFirstNamePool   = newNamePool(metadata.MaleFirstNameFrequency, 0, metadata.MaleFirstNames)
+	femaleFirstNamePool = newNamePool(metadata.FemaleFirstNameFrequency, 0, metadata.FemaleFirstNames)
+)
 
+// generateName generates the name.
+func (b *PersonBuilder) generateName(p *Person) {
+	p.lastName = lastNamePool.pick(b.rng)
 	if p.gender == GenderMale {
-		p.firstName = b.rng.Choice(metadata.MaleFirstNames)
+		p.firstName = maleFirstNamePool.pick(b.rng)
 	} else {
-		p.firstName = b.rng.Choice(metadata.FemaleFirstNames)
+		p.firstName = femaleFirstNamePool.pick(b.rng)
 	}
-
 	p.name = p.lastName + p.firstName
 }
 
@@ -254,34 +1078,84 @@ func (b *PersonBuilder) generateAddress(p *Person) {
 		floor = b.rng.IntRange(10, 26)
 	}
 	room := b.rng.IntRange(1, 5)
-	roomNo := floor*100 + room
 
-	p.address = fmt.Sprintf("%s%s%s%d号%s%d单元%d室",
-		p.province, p.city, street, houseNum, community, unit, roomNo)
+	p.address.Street = street
+	p.address.HouseNumber = strconv.Itoa(houseNum)
+	p.address.Community = community
+	p.address.Unit = strconv.Itoa(unit)
+	p.address.Room = strconv.Itoa(floor*100 + room)
 }
 
-// generateMobile generates the mobile phone number.
+// generateMobile generates the mobile phone number with an H-code (digits
+// 4-7) allocated to the person's city, or to another city if MobileMismatch is
+// set. Segments are weighted by the H-codes they can use, then an H-code is
+// picked uniformly.
 func (b *PersonBuilder) generateMobile(p *Person) {
-	prefix := b.rng.Choice(metadata.MobilePrefix)
-	suffix := b.rng.IntRange(10000000, 100000000)
-	p.mobile = fmt.Sprintf("%s%d", prefix, suffix)
-}
+	city := regionCodes[p.address.AreaCode]
+	if city.level == RegionDistrict {
+		city = city.parent
+	}
 
-// generateBankNo generates the bank card number.
-func (b *PersonBuilder) generateBankNo(p *Person) {
-	bank := metadata.CardBins[b.rng.Intn(len(metadata.CardBins))]
-	prefix := bank.Prefixes[b.rng.Intn(len(bank.Prefixes))]
+	segments, _ := b.mobileSegments()
+	ranges := make([]*hCodeRange, len(segments))
+	weights := make([]float64, len(segments))
+	for i, s := range segments {
+		ranges[i] = cityHCodeRanges[[2]string{s.Prefix, city.code}]
+		n := ranges[i].to - ranges[i].from + 1
+		if b.mobileMismatch {
+			all := hCodeRanges[s.Prefix]
+			n = all[len(all)-1].to - all[0].from + 1 - n
+		}
+		weights[i] = float64(n)
+	}
+	i := b.rng.WeightedIndex(weights)
+	s, r := segments[i], ranges[i]
 
-	prefixStr := fmt.Sprintf("%d", prefix)
-	remainLen := bank.Length - len(prefixStr) - 1
+	var hcode int
+	if b.mobileMismatch {
+		// Pick among the other H-codes of the segment, skipping the city's.
+		hcode = hCodeRanges[s.Prefix][0].from + b.rng.Intn(int(weights[i]))
+		if hcode >= r.from {
+			hcode += r.to - r.from + 1
+		}
+	} else {
+		hcode = r.from + b.rng.Intn(r.to-r.from+1)
+	}
 
-	cardNo := prefixStr
-	for i := 0; i < remainLen; i++ {
-		cardNo += fmt.Sprintf("%d", b.rng.Intn(10))
+	mobile := []byte(fmt.Sprintf("%s%04d", s.Prefix[:3], hcode))
+	for len(mobile) < 11 {
+		mobile = append(mobile, byte('0'+b.rng.Intn(10)))
+	}
+	p.mobile = string(mobile)
+	p.carrier = segmentCarrier(s)
+}
+
+// generateLandline generates the fixed-line phone number in the person's
+// city. Local numbers start with 2-8, as those starting with 0 and 1 are
+// prefixes and those starting with 9 are mostly service numbers.
+func (b *PersonBuilder) generateLandline(p *Person) {
+	code := regionCodes[p.address.AreaCode].phoneCode
+	number := []byte{byte('0' + b.rng.IntRange(2, 9))}
+	for len(number) < phoneCodeDigits[code] {
+		number = append(number, byte('0'+b.rng.Intn(10)))
 	}
 
-	checkDigit := calculateLUHNCheckDigit(cardNo)
-	p.bankNo = cardNo + fmt.Sprintf("%d", checkDigit)
+	var extension int
+	if b.rng.Intn(2) == 0 {
+		extension = b.rng.IntRange(100, 1000)
+	} else {
+		extension = b.rng.IntRange(1000, 10000)
+	}
+
+	p.landline = Landline{AreaCode: code, Number: string(number), Extension: strconv.Itoa(extension)}
+}
+
+// generateBankNo generates the bank card number.
+func (b *PersonBuilder) generateBankNo(p *Person) {
+	banks, _ := b.cardBins()
+	bank := banks[b.rng.Intn(len(banks))]
+	prefix := bank.Prefixes[b.rng.Intn(len(bank.Prefixes))]
+	p.bankNo = bankCardNo(b.rng, strconv.Itoa(prefix), bank.Length)
 }
 
 // generateEmail generates the email address.
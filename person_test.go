@@ -1,9 +1,14 @@
 package chinaid
 
 import (
+	"errors"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/mritd/chinaid/v2/metadata"
 )
 
 func TestNewPerson(t *testing.T) {
@@ -46,6 +51,75 @@ func TestPersonProvince(t *testing.T) {
 	}
 }
 
+func TestPersonCarrier(t *testing.T) {
+	for _, c := range []Carrier{CarrierChinaMobile, CarrierChinaUnicom, CarrierChinaTelecom, CarrierChinaBroadnet, CarrierMVNO} {
+		for _, p := range NewPerson().Carrier(c).Seed(1).BuildN(200) {
+			info, err := ParseMobile(p.Mobile())
+			if err != nil {
+				t.Fatalf("Carrier(%v): ParseMobile(%s) returned error: %v", c, p.Mobile(), err)
+			}
+			if info.Carrier != c || p.MobileCarrier() != c || info.DataOnly {
+				t.Fatalf("Carrier(%v): mobile %s has %+v, MobileCarrier() = %v", c, p.Mobile(), *info, p.MobileCarrier())
+			}
+		}
+	}
+
+	seen := make(map[Carrier]bool)
+	for _, p := range NewPerson().Seed(1).BuildN(2000) {
+		if !ValidateMobile(p.Mobile()) {
+			t.Fatalf("ValidateMobile(%s) = false", p.Mobile())
+		}
+		seen[p.MobileCarrier()] = true
+	}
+	if len(seen) != 5 {
+		t.Errorf("default carriers = %v, want all five", seen)
+	}
+
+	if _, err := NewPerson().Carrier(Carrier(42)).TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+		t.Errorf("Carrier(42): TryBuild error = %v, want ErrUnsatisfiable", err)
+	}
+}
+
+func TestPersonMobileHCode(t *testing.T) {
+	for _, mismatch := range []bool{false, true} {
+		b := NewPerson().Seed(3)
+		if mismatch {
+			b.MobileMismatch()
+		}
+		for _, p := range b.BuildN(500) {
+			home, err := LookupGeneratedMobileCity(p.Mobile())
+			if err != nil {
+				t.Fatalf("LookupGeneratedMobileCity(%s) returned error: %v", p.Mobile(), err)
+			}
+			same := home.Name() == p.City() && home.Province().ShortName() == p.Province()
+			if same == mismatch {
+				t.Fatalf("mismatch %t: mobile %s of %s%s has an H-code of %s",
+					mismatch, p.Mobile(), p.Province(), p.City(), home.FullName())
+			}
+		}
+	}
+}
+
+func TestPersonAddressDetail(t *testing.T) {
+	for _, p := range NewPerson().Seed(7).BuildN(200) {
+		a := p.AddressDetail()
+		if a.Province != p.Province() || a.City != p.City() || a.District != p.District() {
+			t.Fatalf("AddressDetail() region = %s/%s/%s, want %s/%s/%s",
+				a.Province, a.City, a.District, p.Province(), p.City(), p.District())
+		}
+		if a.String() != p.Address() {
+			t.Fatalf("AddressDetail().String() = %s, want %s", a.String(), p.Address())
+		}
+		r, err := LookupRegion(a.AreaCode)
+		if err != nil || r.Name() != p.District() {
+			t.Fatalf("AddressDetail().AreaCode = %s, want the code of %s", a.AreaCode, p.District())
+		}
+		if a.Street == "" || a.HouseNumber == "" || a.Community == "" || a.Unit == "" || a.Room == "" {
+			t.Fatalf("AddressDetail() = %+v, want all components", a)
+		}
+	}
+}
+
 func TestPersonGender(t *testing.T) {
 	male := NewPerson().Gender(GenderMale).Build()
 	digit17 := int(male.IDNo()[16] - '0')
@@ -119,6 +193,14 @@ func TestPersonIDNoValidation(t *testing.T) {
 	}
 }
 
+func TestPersonIDNoStrictValidation(t *testing.T) {
+	for _, p := range NewPerson().Seed(1).AgeRange(0, 100).BuildN(100) {
+		if err := CheckIDNo(p.IDNo(), Strict(), UppercaseX()); err != nil {
+			t.Errorf("CheckIDNo(%s, Strict(), UppercaseX()) = %v, want nil", p.IDNo(), err)
+		}
+	}
+}
+
 func TestPersonBankNoValidation(t *testing.T) {
 	// Generate multiple persons and validate their bank card numbers
 	for i := 0; i < 100; i++ {
@@ -162,3 +244,543 @@ func TestPersonGetters(t *testing.T) {
 		t.Errorf("Gender should be Male or Female, got %v", p.Gender())
 	}
 }
+
+func TestPersonLegacyIDNo(t *testing.T) {
+	for _, p := range NewPerson().LegacyIDNo().AgeRange(20, 80).BuildN(50) {
+		if len(p.IDNo()) != 15 {
+			t.Fatalf("IDNo length should be 15, got %d (%s)", len(p.IDNo()), p.IDNo())
+		}
+		if !ValidateIDNo15(p.IDNo(), Strict()) {
+			t.Errorf("Generated 15-digit IDNo should be valid: %s", p.IDNo())
+		}
+		if y := p.Birthday().Year(); y < 1900 || y > 1999 {
+			t.Errorf("Birth year should be in 1900-1999, got %d", y)
+		}
+	}
+
+	if _, err := NewPerson().LegacyIDNo().AgeRange(1, 5).TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+		t.Errorf("TryBuild error = %v, want ErrUnsatisfiable", err)
+	}
+}
+
+func TestPersonBankOptions(t *testing.T) {
+	for _, p := range NewPerson().Bank("招商银行").BuildN(20) {
+		info, err := LookupBankCard(p.BankNo())
+		if err != nil || info.Bank != "招商银行" {
+			t.Errorf("LookupBankCard(%s) = %+v, %v, want 招商银行", p.BankNo(), info, err)
+		}
+	}
+
+	for _, p := range NewPerson().Bank("广发银行").BuildN(20) {
+		info, err := LookupBankCard(p.BankNo())
+		if err != nil || !strings.Contains(info.Bank, "广发银行") {
+			t.Errorf("LookupBankCard(%s) = %+v, %v, want 广发银行", p.BankNo(), info, err)
+		}
+	}
+
+	for _, p := range NewPerson().CardLength(16).CardType(CardTypeDebit).BuildN(50) {
+		if len(p.BankNo()) != 16 {
+			t.Errorf("BankNo length should be 16, got %s", p.BankNo())
+		}
+		info, err := LookupBankCard(p.BankNo())
+		if err != nil || info.CardType != CardTypeDebit {
+			t.Errorf("LookupBankCard(%s) = %+v, %v, want debit card", p.BankNo(), info, err)
+		}
+	}
+
+	for _, name := range []string{"中国工商银行", "工行", "工商银行股份有限公司"} {
+		info, err := LookupBankCard(NewPerson().Bank(name).Build().BankNo())
+		if err != nil || info.Bank != "工商银行" {
+			t.Errorf("Bank(%s): LookupBankCard = %+v, %v, want 工商银行", name, info, err)
+		}
+	}
+
+	tests := []*PersonBuilder{
+		NewPerson().Bank("不存在的银行"),
+		NewPerson().Bank("银行"),
+		NewPerson().Bank("中国"),
+		NewPerson().Bank("工商"),
+		NewPerson().Bank("工商银行").CardLength(13),
+		NewPerson().CardLength(12),
+	}
+	for _, b := range tests {
+		if _, err := b.TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+			t.Errorf("TryBuild error = %v, want ErrUnsatisfiable", err)
+		}
+	}
+}
+
+func TestPersonCardType(t *testing.T) {
+	for _, ct := range []CardType{CardTypeDebit, CardTypeCredit, CardTypeSemiCredit, CardTypePrepaid} {
+		for _, p := range NewPerson().CardType(ct).BuildN(20) {
+			info, err := LookupBankCard(p.BankNo())
+			if err != nil {
+				t.Fatalf("LookupBankCard(%s) returned error: %v", p.BankNo(), err)
+			}
+			if info.CardType != ct || !info.LengthValid || !info.LUHNValid {
+				t.Errorf("LookupBankCard(%s) = %+v, want valid %s card", p.BankNo(), info, ct)
+			}
+		}
+	}
+
+	for _, p := range NewPerson().Bank("工商银行").CardType(CardTypeCredit).CardLength(16).BuildN(20) {
+		info, err := LookupBankCard(p.BankNo())
+		if err != nil || info.Bank != "工商银行" || info.CardType != CardTypeCredit || len(p.BankNo()) != 16 {
+			t.Errorf("LookupBankCard(%s) = %+v, %v, want 16-digit 工商银行 credit card", p.BankNo(), info, err)
+		}
+	}
+}
+
+func TestPersonBirthdayRangeBoundaries(t *testing.T) {
+	nows := []time.Time{
+		time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC),
+	}
+	for _, now := range nows {
+		b := NewPerson().AgeRange(18, 60)
+		from, to := b.birthdayRange(now)
+		if got := calculateAge(from, now); got != 60 {
+			t.Errorf("now %s: age of earliest birthday %s = %d, want 60", now.Format(time.DateOnly), from.Format(time.DateOnly), got)
+		}
+		if got := calculateAge(from.AddDate(0, 0, -1), now); got != 61 {
+			t.Errorf("now %s: age of day before %s = %d, want 61", now.Format(time.DateOnly), from.Format(time.DateOnly), got)
+		}
+		if got := calculateAge(to, now); got != 18 {
+			t.Errorf("now %s: age of latest birthday %s = %d, want 18", now.Format(time.DateOnly), to.Format(time.DateOnly), got)
+		}
+		if got := calculateAge(to.AddDate(0, 0, 1), now); got != 17 {
+			t.Errorf("now %s: age of day after %s = %d, want 17", now.Format(time.DateOnly), to.Format(time.DateOnly), got)
+		}
+	}
+}
+
+func TestPersonBirthdayCoversAllDays(t *testing.T) {
+	lateDays := 0
+	for _, p := range NewPerson().Seed(1).BuildN(2000) {
+		if p.Birthday().Day() > 28 {
+			lateDays++
+		}
+	}
+	if lateDays == 0 {
+		t.Error("Birthdays should include the 29th, 30th and 31st")
+	}
+
+	from := time.Date(2000, 2, 28, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2000, 3, 1, 0, 0, 0, 0, time.UTC)
+	seen := make(map[string]bool)
+	for _, p := range NewPerson().BirthdayRange(from, to).BuildN(200) {
+		seen[p.Birthday().Format(time.DateOnly)] = true
+	}
+	for _, d := range []string{"2000-02-28", "2000-02-29", "2000-03-01"} {
+		if !seen[d] {
+			t.Errorf("BirthdayRange should produce %s, got %v", d, seen)
+		}
+	}
+}
+
+func TestPersonBirthdayWideRange(t *testing.T) {
+	// Ranges wider than time.Duration can hold must still cover every year.
+	ref := time.Date(2026, 1, 1, 0, 0, 0, 0, chinaLocation)
+	recent := 0
+	for _, p := range NewPerson().Seed(1).ReferenceDate(ref).AgeRange(0, 400).BuildN(1000) {
+		if p.Birthday().Year() >= 1918 {
+			recent++
+		}
+	}
+	// 1918-2025 is about a quarter of the 400-year range.
+	if recent < 150 || recent > 350 {
+		t.Errorf("%d of 1000 birthdays in 1918-2025, want about 270", recent)
+	}
+}
+
+func TestPersonBirthday(t *testing.T) {
+	birthday := time.Date(1996, 2, 29, 0, 0, 0, 0, time.UTC)
+	p := NewPerson().Birthday(birthday).Build()
+	if got := p.Birthday().Format(time.DateOnly); got != "1996-02-29" {
+		t.Errorf("Birthday = %s, want 1996-02-29", got)
+	}
+	if p.IDNo()[6:14] != "19960229" {
+		t.Errorf("IDNo should contain 19960229, got %s", p.IDNo())
+	}
+
+	future := time.Now().AddDate(1, 0, 0)
+	if _, err := NewPerson().Birthday(future).TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+		t.Errorf("TryBuild with future birthday error = %v, want ErrUnsatisfiable", err)
+	}
+	if _, err := NewPerson().BirthdayRange(birthday, birthday.AddDate(0, 0, -1)).TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+		t.Errorf("TryBuild with reversed range error = %v, want ErrUnsatisfiable", err)
+	}
+	if _, err := NewPerson().Birthday(birthday.AddDate(10, 0, 0)).LegacyIDNo().TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+		t.Errorf("TryBuild with legacy 2006 birthday error = %v, want ErrUnsatisfiable", err)
+	}
+}
+
+func TestPersonReferenceDate(t *testing.T) {
+	ref := time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC) // 2025-01-01 04:00 in China
+	p1 := NewPerson().Seed(12345).ReferenceDate(ref).Build()
+	p2 := NewPerson().Seed(12345).ReferenceDate(ref.In(time.FixedZone("PST", -8*60*60))).Build()
+
+	if p1.IDNo() != p2.IDNo() || !p1.Birthday().Equal(p2.Birthday()) {
+		t.Errorf("Same seed and reference date should produce same person: %s vs %s", p1.IDNo(), p2.IDNo())
+	}
+	if _, offset := p1.Birthday().Zone(); offset != 8*60*60 {
+		t.Errorf("Birthday should be in China Standard Time, got offset %d", offset)
+	}
+
+	for _, p := range NewPerson().ReferenceDate(ref).AgeRange(18, 20).BuildN(100) {
+		if age := p.AgeAt(ref); age < 18 || age > 20 {
+			t.Errorf("AgeAt(reference date) should be between 18 and 20, got %d (birthday %s)",
+				age, p.Birthday().Format(time.DateOnly))
+		}
+	}
+}
+
+func TestPersonAgeAt(t *testing.T) {
+	p := NewPerson().Birthday(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).Build()
+
+	// 2017-12-31 16:00 UTC is already 2018-01-01 in China.
+	if got := p.AgeAt(time.Date(2017, 12, 31, 16, 0, 0, 0, time.UTC)); got != 18 {
+		t.Errorf("AgeAt = %d, want 18", got)
+	}
+	if got := p.AgeAt(time.Date(2017, 12, 31, 15, 0, 0, 0, time.UTC)); got != 17 {
+		t.Errorf("AgeAt = %d, want 17", got)
+	}
+}
+
+func TestPersonDerivedAttributes(t *testing.T) {
+	p := NewPerson().Birthday(time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC)).Build()
+
+	if p.IsAdultAt(time.Date(2026, 2, 28, 12, 0, 0, 0, chinaLocation)) {
+		t.Error("Person born 2008-02-29 should not be adult on 2026-02-28")
+	}
+	if !p.IsAdultAt(time.Date(2026, 3, 1, 0, 0, 0, 0, chinaLocation)) {
+		t.Error("Person born 2008-02-29 should be adult on 2026-03-01")
+	}
+	if p.Zodiac() != ZodiacRat {
+		t.Errorf("Zodiac = %s, want 鼠", p.Zodiac().Name())
+	}
+	if p.Constellation() != Pisces {
+		t.Errorf("Constellation = %s, want 双鱼座", p.Constellation().Name())
+	}
+	if p.Generation() != "00后" {
+		t.Errorf("Generation = %s, want 00后", p.Generation())
+	}
+
+	// The zodiac follows the lunar year, which starts at the Spring Festival.
+	for _, tt := range []struct {
+		birthday time.Time
+		want     Zodiac
+	}{
+		{time.Date(1990, 1, 15, 0, 0, 0, 0, time.UTC), ZodiacSnake},
+		{time.Date(1990, 1, 26, 0, 0, 0, 0, time.UTC), ZodiacSnake},
+		{time.Date(1990, 1, 27, 0, 0, 0, 0, time.UTC), ZodiacHorse},
+		{time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC), ZodiacRabbit},
+		{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), ZodiacDragon},
+	} {
+		if got := NewPerson().Birthday(tt.birthday).Build().Zodiac(); got != tt.want {
+			t.Errorf("Zodiac(%s) = %s, want %s", tt.birthday.Format(time.DateOnly), got.Name(), tt.want.Name())
+		}
+	}
+
+	// Leap-year birthdays after Feb 28 used to be off by one day.
+	p = NewPerson().Birthday(time.Date(1992, 3, 1, 0, 0, 0, 0, time.UTC)).Build()
+	if got := p.AgeAt(time.Date(2023, 3, 1, 0, 0, 0, 0, chinaLocation)); got != 31 {
+		t.Errorf("AgeAt(2023-03-01) = %d, want 31", got)
+	}
+	if got := p.AgeAt(time.Date(2024, 2, 29, 0, 0, 0, 0, chinaLocation)); got != 31 {
+		t.Errorf("AgeAt(2024-02-29) = %d, want 31", got)
+	}
+	if p.Generation() != "90后" {
+		t.Errorf("Generation = %s, want 90后", p.Generation())
+	}
+	for _, tt := range []struct {
+		year int
+		want string
+	}{
+		{1905, "1900年代"}, {1949, "1940年代"}, {1950, "50后"}, {2015, "10后"},
+	} {
+		p := NewPerson().Birthday(time.Date(tt.year, 5, 1, 0, 0, 0, 0, time.UTC)).Build()
+		if got := p.Generation(); got != tt.want {
+			t.Errorf("Generation(%d) = %s, want %s", tt.year, got, tt.want)
+		}
+	}
+
+	if !NewPerson().AgeRange(10, 15).Build().IsMinor() {
+		t.Error("Person aged 10-15 should be minor")
+	}
+	if NewPerson().AgeRange(18, 60).Build().IsMinor() {
+		t.Error("Person aged 18-60 should not be minor")
+	}
+}
+
+func TestPersonPopulationSampling(t *testing.T) {
+	counts := map[string]int{}
+	for _, p := range NewPerson().Seed(1).Sampling(SamplingPopulation).BuildN(20000) {
+		counts[p.Province()]++
+	}
+	// 广东 has about 35 times the population of 西藏.
+	if counts["广东"] < 10*counts["西藏"] {
+		t.Errorf("广东 = %d, 西藏 = %d, want population-weighted counts", counts["广东"], counts["西藏"])
+	}
+
+	for _, p := range NewPerson().Seed(1).Province("海南").Sampling(SamplingPopulation).BuildN(500) {
+		if p.City() == "三沙市" {
+			t.Fatal("三沙市 has zero population weight and should never be picked")
+		}
+	}
+}
+
+func TestPersonNameFrequency(t *testing.T) {
+	lastNames, names := map[string]int{}, map[string]int{}
+	for _, p := range NewPerson().Seed(1).Gender(GenderMale).BuildN(20000) {
+		lastNames[p.LastName()]++
+		names[p.Name()]++
+	}
+	// 王, 李 and 张 each make up about 7% of the population, 欧阳 about 0.06%.
+	for _, name := range []string{"王", "李", "张"} {
+		if lastNames[name] < 1000 || lastNames[name] < 20*lastNames["欧阳"] {
+			t.Errorf("%s = %d, 欧阳 = %d, want frequency-weighted last names", name, lastNames[name], lastNames["欧阳"])
+		}
+	}
+	// About 1 in 2500 men is named 张伟.
+	if names["张伟"] < 2 {
+		t.Errorf("张伟 = %d, want common names to collide", names["张伟"])
+	}
+}
+
+func TestNameFrequencies(t *testing.T) {
+	for _, tt := range []struct {
+		freq  map[string]float64
+		lists [][]string
+	}{
+		{metadata.LastNameFrequency, [][]string{metadata.SingleLastName, metadata.CompoundLastName}},
+		{metadata.MaleFirstNameFrequency, [][]string{metadata.MaleFirstNames}},
+		{metadata.FemaleFirstNameFrequency, [][]string{metadata.FemaleFirstNames}},
+	} {
+		total := 0.0
+		for name, w := range tt.freq {
+			total += w
+			found := false
+			for _, list := range tt.lists {
+				found = found || slices.Contains(list, name)
+			}
+			if !found || w <= 0 {
+				t.Errorf("frequency of %s = %v, want a positive frequency of a listed name", name, w)
+			}
+		}
+		if total >= 100 {
+			t.Errorf("frequencies sum to %v%%, want less than 100%%", total)
+		}
+	}
+}
+
+func TestPersonProvinceWeights(t *testing.T) {
+	counts := map[string]int{}
+	persons := NewPerson().Seed(1).ProvinceWeights(map[string]float64{"广东省": 3, "北京": 1}).BuildN(4000)
+	for _, p := range persons {
+		counts[p.Province()]++
+	}
+	if len(counts) != 2 || counts["广东"] < 2*counts["北京"] {
+		t.Errorf("counts = %v, want only 广东 and 北京 at about 3:1", counts)
+	}
+
+	for _, weights := range []map[string]float64{
+		{"火星": 1},
+		{"广东": -1},
+		{"广东": 0},
+	} {
+		if _, err := NewPerson().ProvinceWeights(weights).TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+			t.Errorf("ProvinceWeights(%v) error = %v, want ErrUnsatisfiable", weights, err)
+		}
+	}
+
+	// A later valid call replaces invalid weights.
+	b := NewPerson().Seed(1).ProvinceWeights(map[string]float64{"北京": 1, "火星": 1})
+	p, err := b.ProvinceWeights(map[string]float64{"上海": 1}).TryBuild()
+	if err != nil || p.Province() != "上海" {
+		t.Errorf("TryBuild after valid ProvinceWeights = %v, %v, want a person of 上海", p, err)
+	}
+}
+
+func TestPersonLocationFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *PersonBuilder
+		check   func(p *Person) bool
+	}{
+		{"province full name", NewPerson().Province("浙江省"), func(p *Person) bool { return p.Province() == "浙江" }},
+		{"province abbreviation", NewPerson().Province("浙"), func(p *Person) bool { return p.Province() == "浙江" }},
+		{"province code", NewPerson().ProvinceCode("33"), func(p *Person) bool { return p.Province() == "浙江" }},
+		{"province and code", NewPerson().Province("苏").ProvinceCode("33"), func(p *Person) bool {
+			return p.Province() == "浙江" || p.Province() == "江苏"
+		}},
+		{"city without suffix", NewPerson().City("杭州"), func(p *Person) bool { return p.City() == "杭州市" }},
+		{"districts", NewPerson().City("朝阳区", "浦东新区"), func(p *Person) bool {
+			// 朝阳区 exists in both Beijing and Changchun.
+			return p.District() == "朝阳区" || p.District() == "浦东新区"
+		}},
+		{"district without suffix", NewPerson().Province("浙江").City("西湖"), func(p *Person) bool {
+			return p.City() == "杭州市" && p.District() == "西湖区"
+		}},
+		{"city code", NewPerson().AreaCode("330100"), func(p *Person) bool { return p.City() == "杭州市" }},
+		{"area code", NewPerson().AreaCode("330106"), func(p *Person) bool { return p.IDNo()[:6] == "330106" }},
+		{"area code prefix", NewPerson().AreaCode("4403"), func(p *Person) bool { return p.City() == "深圳市" }},
+		{"exclude province", NewPerson().ExcludeProvince("北京", "沪"), func(p *Person) bool {
+			return p.Province() != "北京" && p.Province() != "上海"
+		}},
+		{"exclude city", NewPerson().Province("海南").ExcludeCity("三沙"), func(p *Person) bool {
+			return p.Province() == "海南" && p.City() != "三沙市"
+		}},
+		{"exclude area code", NewPerson().City("广州").ExcludeAreaCode("440106"), func(p *Person) bool {
+			return p.City() == "广州市" && p.District() != "天河区"
+		}},
+		{"exclude district", NewPerson().City("北京").ExcludeCity("朝阳区"), func(p *Person) bool {
+			return p.City() == "北京市" && p.District() != "朝阳区"
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range tt.builder.Seed(1).BuildN(200) {
+				if !tt.check(p) {
+					t.Fatalf("unexpected person: %s %s %s", p.Province(), p.City(), p.IDNo())
+				}
+			}
+		})
+	}
+}
+
+func TestPersonLocationFiltersUnsatisfiable(t *testing.T) {
+	tests := []*PersonBuilder{
+		NewPerson().Province("火星"),
+		NewPerson().ProvinceCode("99"),
+		NewPerson().City("哥谭"),
+		NewPerson().AreaCode("999999"),
+		NewPerson().AreaCode("3301061"),
+		NewPerson().ExcludeProvince("火星"),
+		NewPerson().Province("浙江").City("广州"),
+		NewPerson().City("杭州").ExcludeCity("杭州市"),
+		NewPerson().Province("北京").ProvinceWeights(map[string]float64{"上海": 1}),
+	}
+	for i, b := range tests {
+		if _, err := b.TryBuild(); !errors.Is(err, ErrUnsatisfiable) {
+			t.Errorf("case %d: TryBuild error = %v, want ErrUnsatisfiable", i, err)
+		}
+	}
+}
+
+func TestPersonDistrict(t *testing.T) {
+	for _, p := range NewPerson().Seed(1).BuildN(1000) {
+		area, ok := metadata.AreaCodeMap[p.AreaCode()]
+		if !ok {
+			t.Fatalf("AreaCode %s not in AreaCodeMap", p.AreaCode())
+		}
+		if area.Province != p.Province() || area.City != p.City() {
+			t.Fatalf("AreaCodeMap[%s] = %+v, person has %s/%s",
+				p.AreaCode(), area, p.Province(), p.City())
+		}
+		year := p.Birthday().Year()
+		if h, ok := metadata.HistoricalAreaCodeMap[p.AreaCode()]; ok {
+			if !h.ValidIn(year) || metadata.AreaCodeMap[h.Current()].District != p.District() {
+				t.Fatalf("historical AreaCode %+v for %s born in %d", *h, p.District(), year)
+			}
+			continue
+		}
+		if area.Historical || area.District != p.District() {
+			t.Fatalf("AreaCodeMap[%s] = %+v, person has district %s", p.AreaCode(), area, p.District())
+		}
+	}
+
+	tests := []struct {
+		areaCode string
+		city     string
+		district string
+		region   string
+	}{
+		{"110105", "北京市", "朝阳区", "北京朝阳区"},
+		{"330106", "杭州市", "西湖区", "浙江杭州市西湖区"},
+		{"441900", "东莞市", "东莞市", "广东东莞市"},
+		{"220202", "吉林市", "昌邑区", "吉林吉林市昌邑区"},
+	}
+	for _, tt := range tests {
+		p := NewPerson().AreaCode(tt.areaCode).Build()
+		if p.City() != tt.city || p.District() != tt.district {
+			t.Errorf("AreaCode(%s): City/District = %s/%s, want %s/%s",
+				tt.areaCode, p.City(), p.District(), tt.city, tt.district)
+		}
+		if !strings.HasPrefix(p.Address(), tt.region) {
+			t.Errorf("AreaCode(%s): Address = %s, want prefix %s", tt.areaCode, p.Address(), tt.region)
+		}
+	}
+}
+
+func TestPersonHistoricalAreaCode(t *testing.T) {
+	tests := []struct {
+		areaCode string
+		year     int
+		want     string
+	}{
+		{"330110", 1990, "330125"}, // 余杭县
+		{"330110", 1998, "330184"}, // 余杭市
+		{"330110", 2010, "330110"}, // 余杭区
+		{"110114", 1985, "110221"}, // 昌平县
+	}
+	for _, tt := range tests {
+		birthday := time.Date(tt.year, 6, 1, 0, 0, 0, 0, time.UTC)
+		for _, p := range NewPerson().AreaCode(tt.areaCode).Birthday(birthday).Seed(1).BuildN(20) {
+			if p.AreaCode() != tt.want || p.IDNo()[:6] != tt.want {
+				t.Fatalf("AreaCode(%s) born in %d: AreaCode = %s, IDNo = %s, want %s",
+					tt.areaCode, tt.year, p.AreaCode(), p.IDNo(), tt.want)
+			}
+			if !ValidateIDNo(p.IDNo(), AreaCodeCheck(), BirthdayCheck()) {
+				t.Fatalf("ValidateIDNo(%s) = false", p.IDNo())
+			}
+		}
+	}
+
+	// 南汇区 took over 南汇县 in 2001 and was merged into 浦东新区 in 2009, so
+	// people of 浦东新区 born in between may carry either 310115 or 310119.
+	seen := make(map[string]bool)
+	birthday := time.Date(2005, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, p := range NewPerson().AreaCode("310115").Birthday(birthday).Seed(1).BuildN(200) {
+		seen[p.AreaCode()] = true
+	}
+	if len(seen) != 2 || !seen["310115"] || !seen["310119"] {
+		t.Errorf("浦东新区 born in 2005: area codes = %v, want 310115 and 310119", seen)
+	}
+}
+
+func TestPersonLandline(t *testing.T) {
+	for _, p := range NewPerson().Seed(11).BuildN(300) {
+		l := p.Landline()
+		if err := CheckLandline(l.WithExtension(), p.City()); err != nil {
+			t.Fatalf("landline %s of %s%s: %v", l.WithExtension(), p.Province(), p.City(), err)
+		}
+		parsed, err := ParseLandline(l.International())
+		if err != nil || parsed.String() != l.String() {
+			t.Fatalf("ParseLandline(%s) = %v, %v, want %s", l.International(), parsed, err, l)
+		}
+		if l.Extension == "" {
+			t.Fatalf("landline %s has no extension", l)
+		}
+	}
+}
+
+func TestPersonPostalCode(t *testing.T) {
+	for _, p := range NewPerson().Seed(13).BuildN(200) {
+		districts, err := LookupPostalCode(p.PostalCode())
+		if err != nil {
+			t.Fatalf("LookupPostalCode(%s) returned error: %v", p.PostalCode(), err)
+		}
+		found := false
+		for _, d := range districts {
+			if d.Name() == p.District() && d.Province().ShortName() == p.Province() {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("postal code %s of %s%s%s maps to %v", p.PostalCode(), p.Province(), p.City(), p.District(), regionNames(districts))
+		}
+	}
+}
@@ -6,17 +6,51 @@ import (
 	"github.com/mritd/chinaid/v2/metadata"
 )
 
-// ConvertPinyin 将中文转换为拼音
+// maxPhraseLen 为 metadata.PinyinPhrases 中词语的最大字数
+var maxPhraseLen = func() int {
+	n := 0
+	for phrase := range metadata.PinyinPhrases {
+		n = max(n, len([]rune(phrase)))
+	}
+	return n
+}()
+
+// ConvertPinyin 将中文转换为拼音，地名中的多音字按 metadata.PinyinPhrases 读音转换
 func ConvertPinyin(chinese string) string {
+	return convertPinyin(chinese, false)
+}
+
+// convertPinyin 将中文转换为拼音；keepASCII 为 true 时保留 ASCII 字母与数字，否则忽略无拼音的字符
+func convertPinyin(chinese string, keepASCII bool) string {
 	var result strings.Builder
-	for _, r := range chinese {
+	runes := []rune(chinese)
+	for i := 0; i < len(runes); {
+		if py, n := phrasePinyin(runes[i:]); n > 0 {
+			result.WriteString(py)
+			i += n
+			continue
+		}
+		r := runes[i]
 		if py, ok := metadata.PinyinMap[r]; ok {
 			result.WriteString(py)
+		} else if keepASCII && ('0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			result.WriteRune(r)
 		}
+		i++
 	}
 	return result.String()
 }
 
+// phrasePinyin 返回 runes 开头最长的多音字词语的拼音及其字数，没有时返回 0
+func phrasePinyin(runes []rune) (string, int) {
+	for n := min(maxPhraseLen, len(runes)); n >= 2; n-- {
+		if py, ok := metadata.PinyinPhrases[string(runes[:n])]; ok {
+			return py, n
+		}
+	}
+	return "", 0
+}
+
 // ConvertPinyinFirst 将中文转换为拼音，只取第一个字的拼音
 func ConvertPinyinFirst(chinese string) string {
 	for _, r := range chinese {
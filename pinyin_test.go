@@ -12,6 +12,8 @@ func TestConvertPinyin(t *testing.T) {
 		{"张伟", "zhangwei"},
 		{"欧阳", "ouyang"},
 		{"司马", "sima"},
+		{"厦门市", "xiamenshi"},
+		{"单县", "shanxian"},
 		{"", ""},
 	}
 
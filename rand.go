@@ -64,3 +64,31 @@ func (rng *Rng) ChoiceRune(slice []rune) rune {
 	}
 	return slice[rng.r.Intn(len(slice))]
 }
+
+// WeightedIndex 按权重随机选择下标，权重不大于 0 的元素不会被选中
+// 所有权重之和不大于 0 时返回 -1
+func (rng *Rng) WeightedIndex(weights []float64) int {
+	total := 0.0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		return -1
+	}
+	r := rng.r.Float64() * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if r < w {
+			return i
+		}
+		r -= w
+		last = i
+	}
+	// 浮点误差兜底
+	return last
+}
@@ -47,6 +47,27 @@ func TestRngChoice(t *testing.T) {
 	}
 }
 
+func TestRngWeightedIndex(t *testing.T) {
+	rng := NewRngWithSeed(1)
+	counts := make([]int, 4)
+	for i := 0; i < 10000; i++ {
+		counts[rng.WeightedIndex([]float64{1, 0, 3, -1})]++
+	}
+	if counts[1] != 0 || counts[3] != 0 {
+		t.Errorf("WeightedIndex picked non-positive weights: %v", counts)
+	}
+	if counts[2] < 2*counts[0] {
+		t.Errorf("WeightedIndex counts = %v, want about 1:3", counts)
+	}
+
+	if got := rng.WeightedIndex([]float64{0, 0}); got != -1 {
+		t.Errorf("WeightedIndex(all zero) = %d, want -1", got)
+	}
+	if got := rng.WeightedIndex(nil); got != -1 {
+		t.Errorf("WeightedIndex(nil) = %d, want -1", got)
+	}
+}
+
 func TestRngConcurrentSafety(t *testing.T) {
 	var wg sync.WaitGroup
 	for i := 0; i < 100; i++ {
@@ -0,0 +1,295 @@
+package chinaid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+// Region is a read-only view of a province, city or district in
+// metadata.Provinces. Regions are shared and must not be modified; all
+// accessors return copies.
+//
+// The municipalities have a single city of the same name, and cities without
+// districts have a single district of the same name and code, so every
+// province has cities and every city has districts.
+type Region struct {
+	code       string
+	name       string
+	shortName  string
+	level      RegionLevel
+	population int
+	phoneCode  string
+	postalCode string
+	parent     *Region
+	children   []*Region
+}
+
+// Code returns the 6-digit code, e.g. "330000", "330100" or "330106".
+func (r *Region) Code() string { return r.code }
+
+// Name returns the name, e.g. "浙江省", "杭州市" or "西湖区".
+func (r *Region) Name() string { return r.name }
+
+// ShortName returns the short name of a province, e.g. "浙江", or the name of
+// a city or district.
+func (r *Region) ShortName() string { return r.shortName }
+
+// Level returns the administrative level.
+func (r *Region) Level() RegionLevel { return r.level }
+
+// Population returns the resident population in ten thousands from the 2020
+// census, or 0 for districts.
+func (r *Region) Population() int { return r.population }
+
+// PhoneCode returns the long-distance area code (长途区号) of the fixed-line
+// phone numbers of a city or district, e.g. "0571", or "" for a province.
+// Neighbouring cities may share an area code, e.g. "0731" for 长沙市,
+// 株洲市 and 湘潭市.
+func (r *Region) PhoneCode() string { return r.phoneCode }
+
+// PostalCode returns the postal code (邮政编码) of a district, e.g. "310013",
+// or "" for a province or a city. A city without districts, such as 东莞市,
+// has the postal code of its single district. The urban districts of a city
+// may share a postal code.
+func (r *Region) PostalCode() string { return r.postalCode }
+
+// Parent returns the province of a city or the city of a district, or nil for
+// a province.
+func (r *Region) Parent() *Region { return r.parent }
+
+// Children returns the cities of a province or the districts of a city, in
+// code order, or nil for a district.
+func (r *Region) Children() []*Region {
+	if len(r.children) == 0 {
+		return nil
+	}
+	return append([]*Region(nil), r.children...)
+}
+
+// Province returns the province the region belongs to, which is r itself for
+// a province.
+func (r *Region) Province() *Region {
+	for r.parent != nil {
+		r = r.parent
+	}
+	return r
+}
+
+// FullName returns the names from the province down, leaving out a city or
+// district named like its parent, e.g. "浙江省杭州市西湖区", "北京市朝阳区" or
+// "广东省东莞市".
+func (r *Region) FullName() string {
+	var names []string
+	for ; r != nil; r = r.parent {
+		if len(names) > 0 && names[len(names)-1] == r.name {
+			continue
+		}
+		names = append(names, r.name)
+	}
+	var sb strings.Builder
+	for i := len(names) - 1; i >= 0; i-- {
+		sb.WriteString(names[i])
+	}
+	return sb.String()
+}
+
+// String returns the full name and the code, e.g. "浙江省杭州市西湖区(330106)".
+func (r *Region) String() string {
+	return fmt.Sprintf("%s(%s)", r.FullName(), r.code)
+}
+
+// regionProvinces holds the regions of metadata.Provinces in order; regionCodes
+// maps province, city and district codes to them, preferring the city for a
+// district that shares its city's code.
+var regionProvinces, regionCodes = buildRegions()
+
+func buildRegions() ([]*Region, map[string]*Region) {
+	var provinces []*Region
+	codes := make(map[string]*Region)
+	for _, prov := range metadata.Provinces {
+		p := &Region{
+			code:       prov.Code + "0000",
+			name:       prov.Name,
+			shortName:  prov.Short,
+			level:      RegionProvince,
+			population: prov.Population,
+		}
+		codes[p.code] = p
+		for _, city := range prov.Cities {
+			c := &Region{
+				code:       city.Code,
+				name:       city.Name,
+				shortName:  city.Name,
+				level:      RegionCity,
+				population: city.Population,
+				phoneCode:  city.PhoneCode,
+				parent:     p,
+			}
+			codes[c.code] = c
+			for _, district := range city.Districts {
+				d := &Region{
+					code:       district.Code,
+					name:       district.Name,
+					shortName:  district.Name,
+					level:      RegionDistrict,
+					phoneCode:  city.PhoneCode,
+					postalCode: district.PostalCode,
+					parent:     c,
+				}
+				if d.code == c.code {
+					c.postalCode = d.postalCode
+				}
+				if _, ok := codes[d.code]; !ok {
+					codes[d.code] = d
+				}
+				c.children = append(c.children, d)
+			}
+			p.children = append(p.children, c)
+		}
+		provinces = append(provinces, p)
+	}
+	return provinces, codes
+}
+
+// Provinces returns the 31 provincial-level regions in code order.
+func Provinces() []*Region {
+	return append([]*Region(nil), regionProvinces...)
+}
+
+// LookupRegion returns the region with the given code. Province and city
+// codes may be given in full or without the trailing zeros, e.g. "33",
+// "330000", "3301" or "330100". Retired area codes are not regions; see
+// metadata.HistoricalAreaCodeMap for them.
+// It returns ErrRegionNotFound if no region has the code.
+func LookupRegion(code string) (*Region, error) {
+	switch len(code) {
+	case 2:
+		code += "0000"
+	case 4:
+		code += "00"
+	}
+	if r, ok := regionCodes[code]; ok {
+		return r, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrRegionNotFound, code)
+}
+
+// LookupPhoneCode returns the cities using a long-distance area code (长途区号),
+// given with or without the leading 0, e.g. "0571" or "571", in code order.
+// Neighbouring cities may share an area code, e.g. "0731" for 长沙市, 株洲市
+// and 湘潭市.
+// It returns ErrRegionNotFound if no city uses the code.
+func LookupPhoneCode(code string) ([]*Region, error) {
+	if !strings.HasPrefix(code, "0") {
+		code = "0" + code
+	}
+	if cities, ok := phoneCodeCities[code]; ok {
+		return append([]*Region(nil), cities...), nil
+	}
+	return nil, fmt.Errorf("%w: phone code %q", ErrRegionNotFound, code)
+}
+
+// postalCodeDistricts maps postal codes, and their first 4 digits that
+// identify the post office of a county, to the districts using them in code
+// order. A district sharing its city's code is represented by the city.
+var postalCodeDistricts = func() map[string][]*Region {
+	m := make(map[string][]*Region)
+	for _, p := range regionProvinces {
+		for _, c := range p.children {
+			for _, d := range c.children {
+				if d.code == c.code {
+					d = c
+				}
+				m[d.postalCode] = append(m[d.postalCode], d)
+				m[d.postalCode[:4]] = append(m[d.postalCode[:4]], d)
+			}
+		}
+	}
+	return m
+}()
+
+// LookupPostalCode returns the districts using a 6-digit postal code
+// (邮政编码) in code order, e.g. "310013" for 西湖区. Postal codes within a
+// district vary by delivery area, so a code no district uses matches the
+// districts sharing its first 4 digits, e.g. "310012" the urban districts of
+// 杭州市.
+// It returns ErrRegionNotFound if no district matches.
+func LookupPostalCode(code string) ([]*Region, error) {
+	if len(code) == 6 && strings.Trim(code, "0123456789") == "" {
+		if districts, ok := postalCodeDistricts[code]; ok {
+			return append([]*Region(nil), districts...), nil
+		}
+		if districts, ok := postalCodeDistricts[code[:4]]; ok {
+			return append([]*Region(nil), districts...), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: postal code %q", ErrRegionNotFound, code)
+}
+
+// FindRegions returns the regions called name, with or without the
+// administrative suffix, e.g. "浙江", "杭州" or "西湖区". Names shared by several
+// places, such as "朝阳区" or "鼓楼区", return all of them in code order.
+// A city or district named like its parent, such as the city of a
+// municipality, is not returned separately.
+func FindRegions(name string) []*Region {
+	if name == "" {
+		return nil
+	}
+	names := []string{name}
+	var result []*Region
+	walkRegions(func(r *Region) {
+		if r.name == name || r.shortName == name || matchCity(r.name, names) {
+			result = append(result, r)
+		}
+	})
+	return result
+}
+
+// SearchRegions returns the regions whose code starts with prefix if it is
+// all digits, or whose name or short name starts with prefix otherwise, in
+// code order, skipping regions named like their parent as FindRegions does.
+// It is meant for autocompletion, e.g. "杭" or "3301".
+func SearchRegions(prefix string) []*Region {
+	if prefix == "" {
+		return nil
+	}
+	digits := true
+	for i := 0; i < len(prefix); i++ {
+		if !isDigit(prefix[i]) {
+			digits = false
+			break
+		}
+	}
+
+	var result []*Region
+	walkRegions(func(r *Region) {
+		if digits {
+			if strings.HasPrefix(r.code, prefix) {
+				result = append(result, r)
+			}
+		} else if strings.HasPrefix(r.name, prefix) || strings.HasPrefix(r.shortName, prefix) {
+			result = append(result, r)
+		}
+	})
+	return result
+}
+
+// walkRegions calls fn for every province, city and district in code order,
+// skipping those named like their parent.
+func walkRegions(fn func(r *Region)) {
+	for _, p := range regionProvinces {
+		fn(p)
+		for _, c := range p.children {
+			if c.name != p.name {
+				fn(c)
+			}
+			for _, d := range c.children {
+				if d.name != c.name {
+					fn(d)
+				}
+			}
+		}
+	}
+}
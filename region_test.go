@@ -0,0 +1,235 @@
+package chinaid
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
+func TestLookupRegion(t *testing.T) {
+	tests := []struct {
+		code     string
+		wantCode string
+		level    RegionLevel
+		fullName string
+	}{
+		{"33", "330000", RegionProvince, "浙江省"},
+		{"330000", "330000", RegionProvince, "浙江省"},
+		{"3301", "330100", RegionCity, "浙江省杭州市"},
+		{"330106", "330106", RegionDistrict, "浙江省杭州市西湖区"},
+		{"110105", "110105", RegionDistrict, "北京市朝阳区"},
+		{"110100", "110100", RegionCity, "北京市"},
+		{"441900", "441900", RegionCity, "广东省东莞市"},
+	}
+	for _, tt := range tests {
+		r, err := LookupRegion(tt.code)
+		if err != nil {
+			t.Fatalf("LookupRegion(%s) returned error: %v", tt.code, err)
+		}
+		if r.Code() != tt.wantCode || r.Level() != tt.level || r.FullName() != tt.fullName {
+			t.Errorf("LookupRegion(%s) = %s %s %s, want %s %s %s",
+				tt.code, r.Code(), r.Level(), r.FullName(), tt.wantCode, tt.level, tt.fullName)
+		}
+	}
+
+	for _, code := range []string{"", "99", "999999", "330125", "3301061"} {
+		if _, err := LookupRegion(code); !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("LookupRegion(%q) error = %v, want ErrRegionNotFound", code, err)
+		}
+	}
+}
+
+func TestRegionNavigation(t *testing.T) {
+	r, _ := LookupRegion("330106")
+	if r.Name() != "西湖区" || r.Parent().Name() != "杭州市" || r.Province().ShortName() != "浙江" {
+		t.Errorf("西湖区: Name/Parent/Province = %s/%s/%s", r.Name(), r.Parent().Name(), r.Province().ShortName())
+	}
+	if r.Children() != nil {
+		t.Errorf("district Children() = %v, want nil", r.Children())
+	}
+	if r.String() != "浙江省杭州市西湖区(330106)" {
+		t.Errorf("String() = %s", r.String())
+	}
+
+	provinces := Provinces()
+	if len(provinces) != len(metadata.Provinces) {
+		t.Fatalf("len(Provinces()) = %d, want %d", len(provinces), len(metadata.Provinces))
+	}
+	districts := 0
+	for _, p := range provinces {
+		if p.Parent() != nil || p.Population() == 0 {
+			t.Errorf("province %s: Parent = %v, Population = %d", p.Name(), p.Parent(), p.Population())
+		}
+		for _, c := range p.Children() {
+			if c.Parent() != p || c.Level() != RegionCity {
+				t.Errorf("city %s: Parent = %s, Level = %s", c.Name(), c.Parent().Name(), c.Level())
+			}
+			for _, d := range c.Children() {
+				if d.Parent() != c || d.Level() != RegionDistrict {
+					t.Errorf("district %s: Parent = %s, Level = %s", d.Name(), d.Parent().Name(), d.Level())
+				}
+				districts++
+			}
+		}
+	}
+	if districts < 2800 {
+		t.Errorf("districts = %d, want at least 2800", districts)
+	}
+
+	// The returned slices are copies.
+	provinces[0] = nil
+	children := Provinces()[0].Children()
+	children[0] = nil
+	if Provinces()[0] == nil || Provinces()[0].Children()[0] == nil {
+		t.Error("modifying returned slices changed the shared regions")
+	}
+}
+
+func TestFindRegions(t *testing.T) {
+	tests := []struct {
+		name  string
+		codes []string
+	}{
+		{"浙江", []string{"330000"}},
+		{"浙江省", []string{"330000"}},
+		{"北京", []string{"110000"}},
+		{"杭州", []string{"330100"}},
+		{"西湖区", []string{"330106", "360103"}},
+		{"东莞", []string{"441900"}},
+		{"哥谭", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		var codes []string
+		for _, r := range FindRegions(tt.name) {
+			codes = append(codes, r.Code())
+		}
+		if len(codes) != len(tt.codes) {
+			t.Errorf("FindRegions(%q) = %v, want %v", tt.name, codes, tt.codes)
+			continue
+		}
+		for i := range codes {
+			if codes[i] != tt.codes[i] {
+				t.Errorf("FindRegions(%q) = %v, want %v", tt.name, codes, tt.codes)
+				break
+			}
+		}
+	}
+
+	if got := FindRegions("朝阳区"); len(got) < 2 {
+		t.Errorf("FindRegions(朝阳区) returned %d regions, want at least 2", len(got))
+	}
+}
+
+func TestSearchRegions(t *testing.T) {
+	for _, r := range SearchRegions("杭") {
+		if r.Name()[:len("杭")] != "杭" {
+			t.Errorf("SearchRegions(杭) returned %s", r.Name())
+		}
+	}
+	if got := SearchRegions("杭州"); len(got) != 1 || got[0].Code() != "330100" {
+		t.Errorf("SearchRegions(杭州) = %v, want [330100]", got)
+	}
+
+	got := SearchRegions("3301")
+	if len(got) == 0 || got[0].Code() != "330100" {
+		t.Fatalf("SearchRegions(3301) = %v, want 330100 first", got)
+	}
+	for _, r := range got[1:] {
+		if r.Level() != RegionDistrict || r.Parent().Code() != "330100" {
+			t.Errorf("SearchRegions(3301) returned %s", r)
+		}
+	}
+	if got := SearchRegions(""); got != nil {
+		t.Errorf("SearchRegions(\"\") = %v, want nil", got)
+	}
+}
+
+func TestLookupPhoneCode(t *testing.T) {
+	tests := []struct {
+		code  string
+		names []string
+	}{
+		{"0571", []string{"杭州市"}},
+		{"571", []string{"杭州市"}},
+		{"010", []string{"北京市"}},
+		{"0731", []string{"长沙市", "株洲市", "湘潭市"}},
+		{"0558", []string{"阜阳市", "亳州市"}},
+	}
+	for _, tt := range tests {
+		cities, err := LookupPhoneCode(tt.code)
+		if err != nil {
+			t.Errorf("LookupPhoneCode(%s) returned error: %v", tt.code, err)
+			continue
+		}
+		if got := regionNames(cities); !slices.Equal(got, tt.names) {
+			t.Errorf("LookupPhoneCode(%s) = %v, want %v", tt.code, got, tt.names)
+		}
+	}
+
+	for _, code := range []string{"", "0", "0001", "05710"} {
+		if _, err := LookupPhoneCode(code); !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("LookupPhoneCode(%q) error = %v, want ErrRegionNotFound", code, err)
+		}
+	}
+}
+
+func TestLookupPostalCode(t *testing.T) {
+	tests := []struct {
+		code  string
+		names []string
+	}{
+		{"310013", []string{"西湖区"}},
+		{"311300", []string{"临安区"}},
+		{"311100", []string{"余杭区", "临平区"}},
+		{"310012", []string{"上城区", "拱墅区", "西湖区", "滨江区", "钱塘区"}},
+		{"523000", []string{"东莞市"}},
+		{"100020", []string{"朝阳区"}},
+	}
+	for _, tt := range tests {
+		districts, err := LookupPostalCode(tt.code)
+		if err != nil {
+			t.Errorf("LookupPostalCode(%s) returned error: %v", tt.code, err)
+			continue
+		}
+		if got := regionNames(districts); !slices.Equal(got, tt.names) {
+			t.Errorf("LookupPostalCode(%s) = %v, want %v", tt.code, got, tt.names)
+		}
+	}
+
+	for _, code := range []string{"", "3100", "31001a", "990000"} {
+		if _, err := LookupPostalCode(code); !errors.Is(err, ErrRegionNotFound) {
+			t.Errorf("LookupPostalCode(%q) error = %v, want ErrRegionNotFound", code, err)
+		}
+	}
+}
+
+func TestRegionPostalCode(t *testing.T) {
+	for _, p := range Provinces() {
+		if p.PostalCode() != "" {
+			t.Errorf("%s: PostalCode() = %q, want empty", p.Name(), p.PostalCode())
+		}
+		for _, c := range p.Children() {
+			for _, d := range c.Children() {
+				code := d.PostalCode()
+				if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+					t.Errorf("%s: invalid postal code %q", d.FullName(), code)
+				}
+			}
+		}
+	}
+	if r, _ := LookupRegion("441900"); r.PostalCode() != "523000" {
+		t.Errorf("东莞市 PostalCode() = %q, want 523000", r.PostalCode())
+	}
+}
+
+func regionNames(regions []*Region) []string {
+	var names []string
+	for _, r := range regions {
+		names = append(names, r.Name())
+	}
+	return names
+}
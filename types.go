@@ -1,5 +1,11 @@
 package chinaid
 
+import (
+	"time"
+
+	"github.com/mritd/chinaid/v2/metadata"
+)
+
 // Gender 性别枚举
 type Gender int
 
@@ -30,3 +36,245 @@ func (g Gender) IsMale() bool {
 func (g Gender) IsFemale() bool {
 	return g == GenderFemale
 }
+
+// Sampling 省份与城市的抽样方式
+type Sampling int
+
+const (
+	SamplingUniform    Sampling = iota // 均匀抽样（默认）
+	SamplingPopulation                 // 按常住人口加权抽样
+)
+
+// String 返回抽样方式的字符串表示
+func (s Sampling) String() string {
+	if s == SamplingPopulation {
+		return "population"
+	}
+	return "uniform"
+}
+
+// RegionLevel 行政区划级别
+type RegionLevel int
+
+const (
+	RegionProvince RegionLevel = iota + 1 // 省级行政区
+	RegionCity                            // 地级行政区
+	RegionDistrict                        // 县级行政区
+)
+
+// String 返回行政区划级别的字符串表示
+func (l RegionLevel) String() string {
+	switch l {
+	case RegionProvince:
+		return "province"
+	case RegionCity:
+		return "city"
+	case RegionDistrict:
+		return "district"
+	default:
+		return "unknown"
+	}
+}
+
+// CardType 银行卡类型
+type CardType int
+
+const (
+	CardTypeRandom     CardType = iota // 随机
+	CardTypeDebit                      // 借记卡
+	CardTypeCredit                     // 贷记卡
+	CardTypeSemiCredit                 // 准贷记卡
+	CardTypePrepaid                    // 预付费卡
+)
+
+// cardTypeNames 卡类型与 metadata.CardBin.CardType 的对应关系
+var cardTypeNames = map[CardType]string{
+	CardTypeDebit:      metadata.DebitCard,
+	CardTypeCredit:     metadata.CreditCard,
+	CardTypeSemiCredit: metadata.SemiCreditCard,
+	CardTypePrepaid:    metadata.PrepaidCard,
+}
+
+// String 返回卡类型的字符串表示
+func (t CardType) String() string {
+	switch t {
+	case CardTypeDebit:
+		return "debit"
+	case CardTypeCredit:
+		return "credit"
+	case CardTypeSemiCredit:
+		return "semi_credit"
+	case CardTypePrepaid:
+		return "prepaid"
+	default:
+		return "random"
+	}
+}
+
+// parseCardType 将 metadata 中的卡类型名称转换为 CardType
+func parseCardType(name string) CardType {
+	for t, n := range cardTypeNames {
+		if n == name {
+			return t
+		}
+	}
+	return CardTypeRandom
+}
+
+// Carrier 手机号运营商
+type Carrier int
+
+const (
+	CarrierRandom        Carrier = iota // 随机
+	CarrierChinaMobile                  // 中国移动
+	CarrierChinaUnicom                  // 中国联通
+	CarrierChinaTelecom                 // 中国电信
+	CarrierChinaBroadnet                // 中国广电
+	CarrierMVNO                         // 虚拟运营商（170、171、162、165、167 号段）
+)
+
+// carrierNames 运营商与 metadata.MobileSegment.Carrier 的对应关系
+var carrierNames = map[Carrier]string{
+	CarrierChinaMobile:   metadata.ChinaMobile,
+	CarrierChinaUnicom:   metadata.ChinaUnicom,
+	CarrierChinaTelecom:  metadata.ChinaTelecom,
+	CarrierChinaBroadnet: metadata.ChinaBroadnet,
+}
+
+// String 返回运营商的字符串表示
+func (c Carrier) String() string {
+	switch c {
+	case CarrierChinaMobile:
+		return "china_mobile"
+	case CarrierChinaUnicom:
+		return "china_unicom"
+	case CarrierChinaTelecom:
+		return "china_telecom"
+	case CarrierChinaBroadnet:
+		return "china_broadnet"
+	case CarrierMVNO:
+		return "mvno"
+	default:
+		return "random"
+	}
+}
+
+// Name 返回运营商的中文名称
+func (c Carrier) Name() string {
+	if c == CarrierMVNO {
+		return "虚拟运营商"
+	}
+	return carrierNames[c]
+}
+
+// parseCarrier 将 metadata 中的运营商名称转换为 Carrier
+func parseCarrier(name string) Carrier {
+	for c, n := range carrierNames {
+		if n == name {
+			return c
+		}
+	}
+	return CarrierRandom
+}
+
+// Zodiac 生肖
+type Zodiac int
+
+const (
+	ZodiacRat     Zodiac = iota // 鼠
+	ZodiacOx                    // 牛
+	ZodiacTiger                 // 虎
+	ZodiacRabbit                // 兔
+	ZodiacDragon                // 龙
+	ZodiacSnake                 // 蛇
+	ZodiacHorse                 // 马
+	ZodiacGoat                  // 羊
+	ZodiacMonkey                // 猴
+	ZodiacRooster               // 鸡
+	ZodiacDog                   // 狗
+	ZodiacPig                   // 猪
+)
+
+var zodiacStrings = []string{
+	"rat", "ox", "tiger", "rabbit", "dragon", "snake",
+	"horse", "goat", "monkey", "rooster", "dog", "pig",
+}
+
+var zodiacNames = []string{"鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"}
+
+// String 返回生肖的字符串表示，无效值返回空字符串
+func (z Zodiac) String() string {
+	if z < 0 || int(z) >= len(zodiacStrings) {
+		return ""
+	}
+	return zodiacStrings[z]
+}
+
+// Name 返回生肖的中文名称，无效值返回空字符串
+func (z Zodiac) Name() string {
+	if z < 0 || int(z) >= len(zodiacNames) {
+		return ""
+	}
+	return zodiacNames[z]
+}
+
+// zodiacOfYear 返回指定年份的生肖（1900 年为鼠年）
+func zodiacOfYear(year int) Zodiac {
+	return Zodiac(((year-1900)%12 + 12) % 12)
+}
+
+// Constellation 星座
+type Constellation int
+
+const (
+	Aries       Constellation = iota // 白羊座 3.21-4.19
+	Taurus                           // 金牛座 4.20-5.20
+	Gemini                           // 双子座 5.21-6.21
+	Cancer                           // 巨蟹座 6.22-7.22
+	Leo                              // 狮子座 7.23-8.22
+	Virgo                            // 处女座 8.23-9.22
+	Libra                            // 天秤座 9.23-10.23
+	Scorpio                          // 天蝎座 10.24-11.22
+	Sagittarius                      // 射手座 11.23-12.21
+	Capricorn                        // 摩羯座 12.22-1.19
+	Aquarius                         // 水瓶座 1.20-2.18
+	Pisces                           // 双鱼座 2.19-3.20
+)
+
+var constellationStrings = []string{
+	"aries", "taurus", "gemini", "cancer", "leo", "virgo",
+	"libra", "scorpio", "sagittarius", "capricorn", "aquarius", "pisces",
+}
+
+var constellationNames = []string{
+	"白羊座", "金牛座", "双子座", "巨蟹座", "狮子座", "处女座",
+	"天秤座", "天蝎座", "射手座", "摩羯座", "水瓶座", "双鱼座",
+}
+
+// constellationStartDays 每月中新星座开始的日期（1 月至 12 月）
+var constellationStartDays = []int{20, 19, 21, 20, 21, 22, 23, 23, 23, 24, 23, 22}
+
+// String 返回星座的字符串表示，无效值返回空字符串
+func (c Constellation) String() string {
+	if c < 0 || int(c) >= len(constellationStrings) {
+		return ""
+	}
+	return constellationStrings[c]
+}
+
+// Name 返回星座的中文名称，无效值返回空字符串
+func (c Constellation) Name() string {
+	if c < 0 || int(c) >= len(constellationNames) {
+		return ""
+	}
+	return constellationNames[c]
+}
+
+// constellationOf 返回指定日期的星座
+func constellationOf(month time.Month, day int) Constellation {
+	c := (int(month) + 9) % 12 // 当月开始的星座
+	if day < constellationStartDays[month-1] {
+		c = (c + 11) % 12
+	}
+	return Constellation(c)
+}
@@ -1,6 +1,9 @@
 package chinaid
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestGenderString(t *testing.T) {
 	tests := []struct {
@@ -36,3 +39,89 @@ func TestGenderIsFemale(t *testing.T) {
 		t.Error("GenderMale.IsFemale() should be false")
 	}
 }
+
+func TestCardTypeString(t *testing.T) {
+	tests := []struct {
+		ct   CardType
+		want string
+	}{
+		{CardTypeRandom, "random"},
+		{CardTypeDebit, "debit"},
+		{CardTypeCredit, "credit"},
+		{CardTypeSemiCredit, "semi_credit"},
+		{CardTypePrepaid, "prepaid"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ct.String(); got != tt.want {
+			t.Errorf("CardType.String() = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestParseCardType(t *testing.T) {
+	for ct, name := range cardTypeNames {
+		if got := parseCardType(name); got != ct {
+			t.Errorf("parseCardType(%s) = %v, want %v", name, got, ct)
+		}
+	}
+}
+
+func TestZodiacOfYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want Zodiac
+	}{
+		{1900, ZodiacRat},
+		{1990, ZodiacHorse},
+		{2000, ZodiacDragon},
+		{2024, ZodiacDragon},
+		{2025, ZodiacSnake},
+	}
+
+	for _, tt := range tests {
+		if got := zodiacOfYear(tt.year); got != tt.want {
+			t.Errorf("zodiacOfYear(%d) = %s, want %s", tt.year, got.Name(), tt.want.Name())
+		}
+	}
+}
+
+func TestConstellationOf(t *testing.T) {
+	tests := []struct {
+		month time.Month
+		day   int
+		want  Constellation
+	}{
+		{time.January, 1, Capricorn},
+		{time.January, 19, Capricorn},
+		{time.January, 20, Aquarius},
+		{time.February, 18, Aquarius},
+		{time.February, 29, Pisces},
+		{time.March, 21, Aries},
+		{time.June, 21, Gemini},
+		{time.June, 22, Cancer},
+		{time.October, 23, Libra},
+		{time.October, 24, Scorpio},
+		{time.December, 21, Sagittarius},
+		{time.December, 22, Capricorn},
+	}
+
+	for _, tt := range tests {
+		if got := constellationOf(tt.month, tt.day); got != tt.want {
+			t.Errorf("constellationOf(%d-%d) = %s, want %s", tt.month, tt.day, got.Name(), tt.want.Name())
+		}
+	}
+}
+
+func TestZodiacConstellationInvalid(t *testing.T) {
+	for _, z := range []Zodiac{-1, 12} {
+		if z.String() != "" || z.Name() != "" {
+			t.Errorf("Zodiac(%d) = %q/%q, want empty", int(z), z.String(), z.Name())
+		}
+	}
+	for _, c := range []Constellation{-1, 12} {
+		if c.String() != "" || c.Name() != "" {
+			t.Errorf("Constellation(%d) = %q/%q, want empty", int(c), c.String(), c.Name())
+		}
+	}
+}